@@ -1,9 +1,8 @@
 package cpu
 
 import (
-	"fmt"
-	"github.com/spf13/cast"
 	"math"
+	"strconv"
 	"strings"
 )
 
@@ -23,22 +22,39 @@ func Parse(cpuSecond string) (uint32, error) {
 }
 
 func ParseMilli(cpuSecond string) (uint32, error) {
-	seconds, err := cast.ToFloat64E(strings.TrimSuffix(cpuSecond, milliSuffix))
-	if err != nil {
-		return 0, fmt.Errorf("cpu: parse millicores failed: %w", err)
+	seconds, err := strconv.ParseFloat(strings.TrimSuffix(cpuSecond, milliSuffix), 64)
+	if err != nil || math.IsNaN(seconds) {
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrInvalidNumber}
+	}
+
+	if seconds < 0 {
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrNegative}
 	}
 
 	if _, float := math.Modf(seconds); float > 0 {
-		return 0, fmt.Errorf("cpu: fractional parts are not allowed when specifying millicores")
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrFractional}
+	}
+
+	if seconds > math.MaxUint32 {
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrOverflow}
 	}
 
-	return cast.ToUint32E(seconds)
+	return uint32(seconds), nil
 }
 
 func ParseCore(cpuSecond string) (uint32, error) {
-	seconds, err := cast.ToFloat32E(cpuSecond)
-	if err != nil {
-		return 0, err
+	parsed, err := strconv.ParseFloat(cpuSecond, 32)
+	if err != nil || math.IsNaN(parsed) {
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrInvalidNumber}
+	}
+
+	seconds := float32(parsed)
+	if seconds < 0 {
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrNegative}
+	}
+
+	if float64(seconds)*Core > math.MaxUint32 {
+		return 0, &ParseError{Input: cpuSecond, Kind: ErrOverflow}
 	}
 
 	return ToSeconds(seconds), nil
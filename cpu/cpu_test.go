@@ -213,3 +213,31 @@ func TestCeil(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkParse(b *testing.B) {
+	values := []string{"2", "2.5", "500m", "2500m"}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, s := range values {
+			_, _ = Parse(s)
+		}
+	}
+}
+
+func BenchmarkParseMilli(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseMilli("2500m")
+	}
+}
+
+func BenchmarkParseCore(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseCore("2.5")
+	}
+}
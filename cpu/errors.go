@@ -0,0 +1,38 @@
+package cpu
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidNumber is returned when a quantity is not a valid number.
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrNegative is returned when a quantity is negative.
+	ErrNegative = errors.New("negative value")
+	// ErrOverflow is returned when a quantity does not fit into uint32 millicores.
+	ErrOverflow = errors.New("value overflows")
+	// ErrUnknownSuffix is returned when the suffix of a Kubernetes quantity is not supported.
+	ErrUnknownSuffix = errors.New("unknown suffix")
+	// ErrFractional is returned when millicores are specified with a fractional part.
+	ErrFractional = errors.New("fractional parts are not allowed when specifying millicores")
+)
+
+// ParseError describes a CPU quantity string that could not be parsed,
+// Kind holds one of the Err* sentinels and is matched by errors.Is.
+type ParseError struct {
+	// Input is the string being parsed.
+	Input string
+	// Kind is the class of the problem, one of the Err* errors.
+	Kind error
+}
+
+// Error implements error.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("cpu: %v in '%s'", e.Kind, e.Input)
+}
+
+// Unwrap returns Kind, so that errors.Is reports the class of the problem.
+func (e *ParseError) Unwrap() error {
+	return e.Kind
+}
@@ -0,0 +1,77 @@
+package cpu
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		cpuSecond string
+		want      error
+	}{
+		{
+			name:      "invalid/Core",
+			cpuSecond: "two",
+			want:      ErrInvalidNumber,
+		},
+		{
+			name:      "invalid/Milli",
+			cpuSecond: "twom",
+			want:      ErrInvalidNumber,
+		},
+		{
+			name:      "invalid/NaN",
+			cpuSecond: "NaN",
+			want:      ErrInvalidNumber,
+		},
+		{
+			name:      "negative/Core",
+			cpuSecond: "-1",
+			want:      ErrNegative,
+		},
+		{
+			name:      "negative/Milli",
+			cpuSecond: "-500m",
+			want:      ErrNegative,
+		},
+		{
+			name:      "fractional",
+			cpuSecond: "2.5m",
+			want:      ErrFractional,
+		},
+		{
+			name:      "overflow/Core",
+			cpuSecond: "5000000",
+			want:      ErrOverflow,
+		},
+		{
+			name:      "overflow/Milli",
+			cpuSecond: "5000000000m",
+			want:      ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.cpuSecond)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.want)
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Input != tt.cpuSecond {
+				t.Errorf("Parse() error = %#v, want *ParseError with input %q", err, tt.cpuSecond)
+			}
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	err := &ParseError{Input: "2.5m", Kind: ErrFractional}
+
+	want := "cpu: fractional parts are not allowed when specifying millicores in '2.5m'"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,40 @@
+package cpu
+
+import "flag"
+
+// Set implements flag.Value, the value is parsed with Parse.
+func (q *Quantity) Set(value string) error {
+	millicores, err := Parse(value)
+	if err != nil {
+		return err
+	}
+
+	*q = Quantity(millicores)
+	return nil
+}
+
+// Type returns the name of the value type, it is required by spf13/pflag.Value.
+func (q *Quantity) Type() string {
+	return "cpu"
+}
+
+// Flag defines a Quantity flag with specified name, default value, and usage string
+// in the given flag set or in flag.CommandLine if it is nil.
+// The return value is the address of a Quantity variable that stores the value of the flag.
+func Flag(fs *flag.FlagSet, name string, def Quantity, usage string) *Quantity {
+	value := new(Quantity)
+	FlagVar(fs, value, name, def, usage)
+	return value
+}
+
+// FlagVar defines a Quantity flag with specified name, default value, and usage string
+// in the given flag set or in flag.CommandLine if it is nil.
+// The argument p points to a Quantity variable in which to store the value of the flag.
+func FlagVar(fs *flag.FlagSet, p *Quantity, name string, def Quantity, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	*p = def
+	fs.Var(p, name, usage)
+}
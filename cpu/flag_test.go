@@ -0,0 +1,60 @@
+package cpu
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    Quantity
+		wantErr bool
+	}{
+		{
+			name: "default",
+			args: []string{},
+			want: Core,
+		},
+		{
+			name: "millicores",
+			args: []string{"--cpu-limit=500m"},
+			want: 500,
+		},
+		{
+			name: "cores",
+			args: []string{"--cpu-limit", "2.5"},
+			want: 2500,
+		},
+		{
+			name:    "invalid",
+			args:    []string{"--cpu-limit=2.5m"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+
+			got := Flag(fs, "cpu-limit", Core, "cpu limit")
+
+			err := fs.Parse(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && *got != tt.want {
+				t.Errorf("Flag() got = %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantity_Type(t *testing.T) {
+	if got := new(Quantity).Type(); got != "cpu" {
+		t.Errorf("Type() = %v, want %v", got, "cpu")
+	}
+}
@@ -0,0 +1,114 @@
+package cpu
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// maxQuantityExponent is the largest magnitude of the exponent of a Kubernetes quantity.
+const maxQuantityExponent = 1000
+
+var (
+	// quantityDecimalSuffixes holds the suffix of the exponent 3*i-9 at index i.
+	quantityDecimalSuffixes = []string{"n", "u", "m", "", "k", "M", "G", "T", "P", "E"}
+
+	// quantityBinarySuffixes holds the suffix of the power 1024^i at index i.
+	quantityBinarySuffixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+)
+
+// ParseQuantity parses a CPU quantity in the Kubernetes grammar (eg. "500m", "0.5", "2", "250000n", "1e3")
+// and returns the Quantity, suffixes are case-sensitive, and fractional millicores are rounded up
+// as Kubernetes does.
+func ParseQuantity(quantity string) (Quantity, error) {
+	i := 0
+	if i < len(quantity) && (quantity[i] == '+' || quantity[i] == '-') {
+		i++
+	}
+
+	digits := 0
+	for ; i < len(quantity) && ('0' <= quantity[i] && quantity[i] <= '9' || quantity[i] == '.'); i++ {
+		if quantity[i] != '.' {
+			digits++
+		}
+	}
+
+	number, suffix := quantity[:i], quantity[i:]
+
+	value, ok := new(big.Rat).SetString(number)
+	if digits == 0 || strings.Count(number, ".") > 1 || !ok {
+		return 0, &ParseError{Input: quantity, Kind: ErrInvalidNumber}
+	}
+
+	exp, base, ok := quantitySuffix(suffix)
+	if !ok {
+		return 0, &ParseError{Input: quantity, Kind: ErrUnknownSuffix}
+	}
+
+	if exp > maxQuantityExponent || exp < -maxQuantityExponent {
+		return 0, &ParseError{Input: quantity, Kind: ErrInvalidNumber}
+	}
+
+	if value.Sign() < 0 {
+		return 0, &ParseError{Input: quantity, Kind: ErrNegative}
+	}
+
+	value.Mul(value, new(big.Rat).SetInt(base))
+
+	// the quantity is in cores, a core is 10^3 millicores
+	if exp += 3; exp >= 0 {
+		value.Mul(value, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)))
+	} else {
+		value.Quo(value, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil)))
+	}
+
+	millicores, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() > 0 {
+		millicores.Add(millicores, big.NewInt(1))
+	}
+
+	if !millicores.IsUint64() || millicores.Uint64() > math.MaxUint32 {
+		return 0, &ParseError{Input: quantity, Kind: ErrOverflow}
+	}
+
+	return Quantity(millicores.Uint64()), nil
+}
+
+// FormatQuantity returns the canonical Kubernetes representation of the quantity (eg. "500m", "2", "1500m", "20k").
+func FormatQuantity(quantity Quantity) string {
+	if quantity == 0 {
+		return "0"
+	}
+
+	value, exp := uint64(quantity), -3
+	for value%1000 == 0 {
+		value /= 1000
+		exp += 3
+	}
+
+	return strconv.FormatUint(value, 10) + quantityDecimalSuffixes[(exp+9)/3]
+}
+
+// quantitySuffix returns the decimal exponent and the binary base of the suffix of a Kubernetes quantity,
+// the suffix is an exponent only if it is not one of the named suffixes (eg. "Ei").
+func quantitySuffix(suffix string) (int, *big.Int, bool) {
+	for i, name := range quantityDecimalSuffixes {
+		if suffix == name {
+			return 3*i - 9, big.NewInt(1), true
+		}
+	}
+
+	for i, name := range quantityBinarySuffixes[1:] {
+		if suffix == name {
+			return 0, new(big.Int).Lsh(big.NewInt(1), uint(10*(i+1))), true
+		}
+	}
+
+	if len(suffix) > 1 && (suffix[0] == 'e' || suffix[0] == 'E') {
+		exp, err := strconv.Atoi(suffix[1:])
+		return exp, big.NewInt(1), err == nil
+	}
+
+	return 0, nil, false
+}
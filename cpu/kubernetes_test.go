@@ -0,0 +1,203 @@
+package cpu
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity string
+		want     Quantity
+		wantErr  error
+	}{
+		{
+			name:     "cores",
+			quantity: "2",
+			want:     2 * Core,
+		},
+		{
+			name:     "fractionalCores",
+			quantity: "0.5",
+			want:     500,
+		},
+		{
+			name:     "millicores",
+			quantity: "250m",
+			want:     250,
+		},
+		{
+			name:     "microcores",
+			quantity: "2500u",
+			want:     3,
+		},
+		{
+			name:     "nanocores",
+			quantity: "250000000n",
+			want:     250,
+		},
+		{
+			name:     "nanocores/roundedUp",
+			quantity: "1n",
+			want:     1,
+		},
+		{
+			name:     "exponent",
+			quantity: "1e3",
+			want:     1000 * Core,
+		},
+		{
+			name:     "exponent/negative",
+			quantity: "5E-1",
+			want:     500,
+		},
+		{
+			name:     "kilo",
+			quantity: "2k",
+			want:     2000 * Core,
+		},
+		{
+			name:     "binary",
+			quantity: "1Ki",
+			want:     1024 * Core,
+		},
+		{
+			name:     "leadingDot",
+			quantity: ".1",
+			want:     100,
+		},
+		{
+			name:     "negativeZero",
+			quantity: "-0",
+			want:     0,
+		},
+		{
+			name:     "negative",
+			quantity: "-100m",
+			wantErr:  ErrNegative,
+		},
+		{
+			name:     "overflow",
+			quantity: "5M",
+			wantErr:  ErrOverflow,
+		},
+		{
+			name:     "unknownSuffix",
+			quantity: "2cores",
+			wantErr:  ErrUnknownSuffix,
+		},
+		{
+			name:     "lowerCaseBinary",
+			quantity: "1ki",
+			wantErr:  ErrUnknownSuffix,
+		},
+		{
+			name:     "empty",
+			quantity: "",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "twoDots",
+			quantity: "1.2.3",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "hugeExponent",
+			quantity: "1e100000",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "exbi",
+			quantity: "1Ei",
+			wantErr:  ErrOverflow,
+		},
+		{
+			name:     "exbi/two",
+			quantity: "2Ei",
+			wantErr:  ErrOverflow,
+		},
+		{
+			name:     "exponent/upper",
+			quantity: "2E3",
+			want:     2000 * Core,
+		},
+		{
+			name:     "exponent/signed",
+			quantity: "5e-1",
+			want:     500,
+		},
+		{
+			name:     "exponent/missing",
+			quantity: "1Ex",
+			wantErr:  ErrUnknownSuffix,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuantity(tt.quantity)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseQuantity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuantity() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity Quantity
+		want     string
+	}{
+		{
+			name:     "zero",
+			quantity: 0,
+			want:     "0",
+		},
+		{
+			name:     "millicores",
+			quantity: 500,
+			want:     "500m",
+		},
+		{
+			name:     "mixed",
+			quantity: 1500,
+			want:     "1500m",
+		},
+		{
+			name:     "cores",
+			quantity: 2 * Core,
+			want:     "2",
+		},
+		{
+			name:     "kilocores",
+			quantity: 20000 * Core,
+			want:     "20k",
+		},
+		{
+			name:     "max",
+			quantity: 4294967295,
+			want:     "4294967295m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatQuantity(tt.quantity); got != tt.want {
+				t.Errorf("FormatQuantity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantity_RoundTrip(t *testing.T) {
+	for _, quantity := range []Quantity{0, 1, 500, 1500, 2 * Core, 20000 * Core, 4294967000, 4294967295} {
+		formatted := FormatQuantity(quantity)
+		if got, err := ParseQuantity(formatted); err != nil || got != quantity {
+			t.Errorf("ParseQuantity(%q) = %d, %v, want %d", formatted, got, err, quantity)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package cpu
+
+import "strconv"
+
+// Quantity represents an amount of CPU time in millicores.
+type Quantity uint32
+
+// String returns the quantity in cores when it is a whole number of them
+// and in millicores otherwise (eg. "2", "500m").
+func (q Quantity) String() string {
+	if q%Core == 0 {
+		return strconv.FormatUint(uint64(q/Core), 10)
+	}
+
+	return strconv.FormatUint(uint64(q), 10) + milliSuffix
+}
+
+// Millicores returns the quantity as an integer number of millicores.
+func (q Quantity) Millicores() uint32 {
+	return uint32(q)
+}
+
+// Cores returns the quantity as a floating point number of cores.
+func (q Quantity) Cores() float32 {
+	return ToCpu(uint32(q))
+}
@@ -0,0 +1,53 @@
+package cpu
+
+import "testing"
+
+func TestQuantity_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity Quantity
+		want     string
+	}{
+		{
+			name:     "zero",
+			quantity: 0,
+			want:     "0",
+		},
+		{
+			name:     "cores",
+			quantity: 2 * Core,
+			want:     "2",
+		},
+		{
+			name:     "millicores",
+			quantity: 500 * MilliCore,
+			want:     "500m",
+		},
+		{
+			name:     "mixed",
+			quantity: 2500,
+			want:     "2500m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quantity.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantity_Cores(t *testing.T) {
+	if got := Quantity(1500).Cores(); got != 1.5 {
+		t.Errorf("Cores() = %v, want %v", got, 1.5)
+	}
+}
+
+func BenchmarkQuantity_String(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = Quantity(2500).String()
+	}
+}
@@ -0,0 +1,58 @@
+package cpu
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+)
+
+const (
+	// EncodingMillicores encodes quantities as an integer number of millicores.
+	EncodingMillicores Encoding = iota
+	// EncodingString encodes quantities as strings (eg. "2", "250m").
+	EncodingString
+)
+
+// Encoding defines how a Quantity is represented when written to a database.
+type Encoding uint8
+
+// ValueEncoding is the representation used by Quantity.Value.
+var ValueEncoding = EncodingMillicores
+
+// Scan implements sql.Scanner, it accepts integer columns holding a number of millicores
+// and text columns holding a value understood by Parse.
+func (q *Quantity) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case int64:
+		if value < 0 {
+			return fmt.Errorf("cpu: cannot scan value %d: %w", value, ErrNegative)
+		}
+
+		if value > math.MaxUint32 {
+			return fmt.Errorf("cpu: cannot scan value %d: %w", value, ErrOverflow)
+		}
+
+		*q = Quantity(value)
+		return nil
+	case []byte:
+		return q.Set(string(value))
+	case string:
+		return q.Set(value)
+	case nil:
+		return fmt.Errorf("cpu: cannot scan NULL")
+	}
+
+	return fmt.Errorf("cpu: cannot scan type %T", src)
+}
+
+// Value implements driver.Valuer according to ValueEncoding.
+func (q Quantity) Value() (driver.Value, error) {
+	switch ValueEncoding {
+	case EncodingMillicores:
+		return int64(q), nil
+	case EncodingString:
+		return q.String(), nil
+	}
+
+	return nil, fmt.Errorf("cpu: encoding '%d' unknown", ValueEncoding)
+}
@@ -0,0 +1,103 @@
+package cpu
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+func TestQuantity_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Quantity
+		wantErr bool
+		kind    error
+	}{
+		{
+			name: "int64",
+			src:  int64(250),
+			want: 250,
+		},
+		{
+			name: "bytes",
+			src:  []byte("250m"),
+			want: 250,
+		},
+		{
+			name: "string",
+			src:  "2",
+			want: 2000,
+		},
+		{
+			name:    "negative",
+			src:     int64(-1),
+			wantErr: true,
+			kind:    ErrNegative,
+		},
+		{
+			name:    "overflow",
+			src:     int64(1 << 32),
+			wantErr: true,
+			kind:    ErrOverflow,
+		},
+		{
+			name:    "null",
+			src:     nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Quantity
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.kind != nil && !errors.Is(err, tt.kind) {
+				t.Errorf("Scan() error = %v, want %v", err, tt.kind)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantity_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		quantity Quantity
+		want     driver.Value
+	}{
+		{
+			name:     "millicores",
+			encoding: EncodingMillicores,
+			quantity: 250,
+			want:     int64(250),
+		},
+		{
+			name:     "string",
+			encoding: EncodingString,
+			quantity: 250,
+			want:     "250m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding Encoding) { ValueEncoding = encoding }(ValueEncoding)
+			ValueEncoding = tt.encoding
+
+			got, err := tt.quantity.Value()
+			if err != nil {
+				t.Errorf("Value() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
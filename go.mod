@@ -1,8 +1,3 @@
 module github.com/Diez37/units
 
 go 1.19
-
-require (
-	github.com/spf13/cast v1.5.0
-	github.com/thoas/go-funk v0.9.3
-)
@@ -0,0 +1,151 @@
+package size
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	ZettaByte  Suffix = "ZB"
+	YottaByte  Suffix = "YB"
+	RonnaByte  Suffix = "RB"
+	QuettaByte Suffix = "QB"
+
+	ZebiByte Suffix = "ZiB"
+	YobiByte Suffix = "YiB"
+)
+
+// BigSuffixes represents the specification of the ratio of size to suffix
+// for sizes that do not fit into 64 bits.
+type BigSuffixes []*struct {
+	Unit   *big.Int
+	Suffix Suffix
+}
+
+var (
+	bigDecimalSuffixes = newBigSuffixes(decimalSuffixes, DecimalBase, ZettaByte, YottaByte, RonnaByte, QuettaByte)
+
+	bigBinarySuffixes = newBigSuffixes(binarySuffixes, BinaryBase, ZebiByte, YobiByte)
+
+	bigUnits = newBigUnits(bigDecimalSuffixes, bigBinarySuffixes)
+)
+
+// ParseBigSize defines the IEC/SI prefix, including the zetta, yotta, ronna and quetta
+// prefixes, and returns the size as big.Int or returns an error if it fails,
+// units are case-insensitive, the 'b' suffix is optional, and long names (eg. "zettabytes") are accepted.
+func ParseBigSize(size string) (*big.Int, error) {
+	tok, err := scanSize(size)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, exist := lookupUnit(size[tok.unitOffset:tok.end], bigUnits, true)
+	if !exist {
+		unit, exist = lookupLongName(size[tok.unitOffset:tok.end], bigUnits, false)
+	}
+
+	if !exist {
+		available := make([]string, 0, len(bigDecimalSuffixes)+len(bigBinarySuffixes))
+		for _, suffix := range bigDecimalSuffixes {
+			available = append(available, string(suffix.Suffix))
+		}
+
+		for _, suffix := range bigBinarySuffixes[1:] {
+			available = append(available, string(suffix.Suffix))
+		}
+
+		return nil, unknownUnitError(size, tok.unitOffset, size[tok.unitOffset:tok.end], available)
+	}
+
+	value, err := tok.number.bigScale(unit, DefaultRounding)
+	if err != nil {
+		return nil, &ParseError{Input: size, Offset: tok.numberOffset, Kind: err}
+	}
+
+	return value, nil
+}
+
+// FormatBigHuman returns a human-readable approximation of a size of arbitrary
+// magnitude using SI prefixes up to quetta (eg. "25MB", "1.5ZB", "400QB").
+func FormatBigHuman(size *big.Int) string {
+	return FormatBigSize(FormatDefault, size, bigDecimalSuffixes)
+}
+
+// FormatBigBinary returns a human-readable approximation of a size of arbitrary
+// magnitude using IEC prefixes up to yobi (eg. "512KiB", "2.5ZiB").
+func FormatBigBinary(size *big.Int) string {
+	return FormatBigSize(FormatDefault, size, bigBinarySuffixes)
+}
+
+// FormatBigSize returns a human-readable approximation of the size
+// using the given format and the given BigSuffixes specification.
+func FormatBigSize(format string, size *big.Int, suffixes BigSuffixes) string {
+	suffix := suffixes[0]
+	for i := len(suffixes) - 1; i >= 0; i-- {
+		if size.Cmp(suffixes[i].Unit) >= 0 {
+			suffix = suffixes[i]
+			break
+		}
+	}
+
+	value, _ := new(big.Rat).SetFrac(size, suffix.Unit).Float64()
+
+	formatted := fmt.Sprintf(format, value, suffix.Suffix)
+	if i := strings.Index(formatted, "e+"); i >= 0 {
+		return fixedNotation(formatted, i, value)
+	}
+
+	return formatted
+}
+
+// fixedNotation replaces the number in exponent notation around formatted[exp] (eg. "8.272e+05YiB"),
+// which %g falls back to for values of the largest unit beyond its precision, with the value
+// in fixed notation (eg. "827180.6126YiB").
+func fixedNotation(formatted string, exp int, value float64) string {
+	start := exp
+	for start > 0 && (isDigit(formatted[start-1]) || formatted[start-1] == '.') {
+		start--
+	}
+
+	end := exp + len("e+")
+	for end < len(formatted) && isDigit(formatted[end]) {
+		end++
+	}
+
+	fixed := strings.TrimSuffix(strings.TrimRight(strconv.FormatFloat(value, 'f', 4, 64), "0"), ".")
+	return formatted[:start] + fixed + formatted[end:]
+}
+
+// newBigSuffixes extends the 64-bit Suffixes specification with the given larger suffixes,
+// each next suffix is base times greater than the previous one.
+func newBigSuffixes(suffixes Suffixes, base int64, larger ...Suffix) BigSuffixes {
+	result := make(BigSuffixes, 0, len(suffixes)+len(larger))
+	for _, suffix := range suffixes {
+		result = append(result, &struct {
+			Unit   *big.Int
+			Suffix Suffix
+		}{Unit: new(big.Int).SetUint64(uint64(suffix.Unit)), Suffix: suffix.Suffix})
+	}
+
+	for _, suffix := range larger {
+		result = append(result, &struct {
+			Unit   *big.Int
+			Suffix Suffix
+		}{Unit: new(big.Int).Mul(result[len(result)-1].Unit, big.NewInt(base)), Suffix: suffix})
+	}
+
+	return result
+}
+
+func newBigUnits(suffixes ...BigSuffixes) map[string]*big.Int {
+	units := map[string]*big.Int{}
+	for _, list := range suffixes {
+		for _, suffix := range list {
+			units[strings.ToLower(string(suffix.Suffix))] = suffix.Unit
+		}
+	}
+
+	return units
+}
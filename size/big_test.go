@@ -0,0 +1,243 @@
+package size
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestParseBigSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Byte",
+			size: "512B",
+			want: "512",
+		},
+		{
+			name: "ExaByte",
+			size: "16EB",
+			want: "16000000000000000000",
+		},
+		{
+			name: "ExbiByte",
+			size: "16EiB",
+			want: "18446744073709551616",
+		},
+		{
+			name: "ZettaByte",
+			size: "12ZB",
+			want: "12000000000000000000000",
+		},
+		{
+			name: "ZebiByte/NoSuffixByte",
+			size: "12Zi",
+			want: "14167099448608935641088",
+		},
+		{
+			name: "YottaByte/Fraction",
+			size: "1.5 YB",
+			want: "1500000000000000000000000",
+		},
+		{
+			name: "LongName/ZettaByte",
+			size: "1 zettabyte",
+			want: "1000000000000000000000",
+		},
+		{
+			name: "LongName/QuettaBytes",
+			size: "2 Quettabytes",
+			want: "2000000000000000000000000000000",
+		},
+		{
+			name: "LongName/YobiBytes",
+			size: "1 yobibytes",
+			want: "1208925819614629174706176",
+		},
+		{
+			name: "LongName/Bytes",
+			size: "512 bytes",
+			want: "512",
+		},
+		{
+			name: "YobiByte",
+			size: "10yib",
+			want: "12089258196146291747061760",
+		},
+		{
+			name: "RonnaByte",
+			size: "10RB",
+			want: "10000000000000000000000000000",
+		},
+		{
+			name: "QuettaByte",
+			size: "10QB",
+			want: "10000000000000000000000000000000",
+		},
+		{
+			name:    "unknown",
+			size:    "10XB",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			size:    "ten",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBigSize(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBigSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseBigSize() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatBigHuman(t *testing.T) {
+	tests := []struct {
+		name string
+		size string
+		want string
+	}{
+		{
+			name: "Byte",
+			size: "512",
+			want: "512B",
+		},
+		{
+			name: "ExaByte",
+			size: "16000000000000000000",
+			want: "16EB",
+		},
+		{
+			name: "ZettaByte",
+			size: "1500000000000000000000",
+			want: "1.5ZB",
+		},
+		{
+			name: "QuettaByte",
+			size: "400000000000000000000000000000000",
+			want: "400QB",
+		},
+		{
+			name: "BeyondQuettaByte",
+			size: "12345600000000000000000000000000000",
+			want: "12345.6QB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, _ := new(big.Int).SetString(tt.size, 10)
+			if got := FormatBigHuman(size); got != tt.want {
+				t.Errorf("FormatBigHuman() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatBigBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		size string
+		want string
+	}{
+		{
+			name: "KibiByte",
+			size: "524288",
+			want: "512KiB",
+		},
+		{
+			name: "ExbiByte",
+			size: "18446744073709551616",
+			want: "16EiB",
+		},
+		{
+			name: "ZebiByte",
+			size: "2951479051793528258560",
+			want: "2.5ZiB",
+		},
+		{
+			name: "YobiByte",
+			size: "12089258196146291747061760",
+			want: "10YiB",
+		},
+		{
+			name: "QuettaByte",
+			size: "1000000000000000000000000000000",
+			want: "827180.6126YiB",
+		},
+		{
+			name: "BeyondYobiByte",
+			size: "12089258196146291747061760000000",
+			want: "10000000YiB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, _ := new(big.Int).SetString(tt.size, 10)
+			if got := FormatBigBinary(size); got != tt.want {
+				t.Errorf("FormatBigBinary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleParseBigSize() {
+	total, _ := ParseBigSize("12.5ZB")
+
+	fmt.Println(total)
+	fmt.Println(FormatBigHuman(total))
+	fmt.Println(FormatBigBinary(total))
+	// Output:
+	// 12500000000000000000000
+	// 12.5ZB
+	// 10.59ZiB
+}
+
+func BenchmarkParseBigSize(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseBigSize("12.5ZB")
+	}
+}
+
+func BenchmarkFormatBigHuman(b *testing.B) {
+	size, _ := new(big.Int).SetString("12500000000000000000000", 10)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatBigHuman(size)
+	}
+}
+
+func BenchmarkFormatBigBinary(b *testing.B) {
+	size, _ := new(big.Int).SetString("12500000000000000000000", 10)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatBigBinary(size)
+	}
+}
+
+func BenchmarkFormatBigSize(b *testing.B) {
+	size, _ := new(big.Int).SetString("12500000000000000000000", 10)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatBigSize(FormatDefault, size, bigDecimalSuffixes)
+	}
+}
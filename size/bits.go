@@ -0,0 +1,138 @@
+package size
+
+import "strings"
+
+// BitsPerByte is the number of bits in a byte.
+const BitsPerByte = 8
+
+var (
+	bitPrefixes = newBitPrefixes(allUnits)
+
+	bitUnitNames = newBitUnitNames(unitNames(allUnits))
+
+	// bitLongPrefixes maps the prefixes of the long names to the prefixes of their symbols (eg. "kibi" to "ki").
+	bitLongPrefixes = newBitLongPrefixes(longNames)
+)
+
+// ParseBits defines the IEC/SI prefix and returns the number of bits in the size or returns an error if it fails,
+// the unit is bit-aware: a lowercase 'b', "bit" or "bits" denotes bits (eg. "100Mb", "1Kibit")
+// and an uppercase 'B' denotes bytes (eg. "1MB" is 8000000 bits), prefixes are case-insensitive.
+func ParseBits(size string) (uint64, error) {
+	tok, unit, isBits, err := parseBitUnit(size)
+	if err != nil {
+		return 0, err
+	}
+
+	if !isBits {
+		unit *= BitsPerByte
+	}
+
+	value, err := tok.number.scale(unit, DefaultRounding)
+	if err != nil {
+		return 0, &ParseError{Input: size, Offset: tok.numberOffset, Kind: err}
+	}
+
+	return uint64(value), nil
+}
+
+// ParseBitAware is Parse with bit-aware units: a lowercase 'b', "bit" or "bits" denotes bits (eg. "100Mb"
+// is 12500000 bytes) and an uppercase 'B' denotes bytes, fractional bytes are resolved with DefaultRounding.
+// Parse stays the lenient default and treats 'b' and 'B' alike.
+func ParseBitAware(size string) (Size, error) {
+	return ParseBitAwareRounding(size, DefaultRounding)
+}
+
+// ParseBitAwareRounding is ParseBitAware with the given Rounding for values that resolve to a fractional number of bytes.
+func ParseBitAwareRounding(size string, rounding Rounding) (Size, error) {
+	tok, unit, isBits, err := parseBitUnit(size)
+	if err != nil {
+		return 0, err
+	}
+
+	if isBits {
+		tok.number.shift = 3
+	}
+
+	value, err := tok.number.scale(unit, rounding)
+	if err != nil {
+		return 0, &ParseError{Input: size, Offset: tok.numberOffset, Kind: err}
+	}
+
+	return value, nil
+}
+
+// parseBitUnit scans a size with a bit-aware unit and returns the value of its prefix
+// and whether the unit denotes bits rather than bytes.
+func parseBitUnit(size string) (token, Size, bool, error) {
+	tok, err := scanSize(size)
+	if err != nil {
+		return token{}, 0, false, err
+	}
+
+	name := size[tok.unitOffset:tok.end]
+	if len(name) == 0 {
+		return token{}, 0, false, &ParseError{Input: size, Offset: tok.unitOffset, Kind: ErrMissingUnit}
+	}
+
+	prefix, isBits, ok := splitBitUnit(name)
+	if ok {
+		if unit, exist := lookupUnit(prefix, bitPrefixes, false); exist {
+			return tok, unit, isBits, nil
+		}
+	}
+
+	return token{}, 0, false, unknownUnitError(size, tok.unitOffset, name, bitUnitNames)
+}
+
+// splitBitUnit splits a unit into its prefix and reports whether the unit denotes bits,
+// ok is false if the unit denotes neither bits nor bytes. Long names (eg. "megabits", "kibibytes")
+// are case-insensitive and split into the prefix of their symbol.
+func splitBitUnit(name string) (prefix string, isBits, ok bool) {
+	lower := strings.ToLower(name)
+	for _, long := range []string{"bytes", "byte", "bits", "bit"} {
+		if prefix, exist := bitLongPrefixes[strings.TrimSuffix(lower, long)]; exist && strings.HasSuffix(lower, long) {
+			return prefix, strings.HasPrefix(long, "bit"), true
+		}
+	}
+
+	for _, suffix := range []string{"bits", "bit", "b"} {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix), true, true
+		}
+	}
+
+	if strings.HasSuffix(name, "B") {
+		return strings.TrimSuffix(name, "B"), false, true
+	}
+
+	return "", false, false
+}
+
+// newBitPrefixes strips the byte suffix from the units, leaving their prefixes (eg. "k", "ki").
+func newBitPrefixes(units Units) Units {
+	prefixes := Units{}
+	for name, unit := range units {
+		prefixes[strings.TrimSuffix(name, "b")] = unit
+	}
+
+	return prefixes
+}
+
+func newBitLongPrefixes(names map[Suffix]longName) map[string]string {
+	prefixes := map[string]string{}
+	for suffix, name := range names {
+		prefixes[strings.TrimSuffix(name.singular, "byte")] = strings.ToLower(strings.TrimSuffix(string(suffix), "B"))
+	}
+
+	return prefixes
+}
+
+// newBitUnitNames lists the byte unit followed by the bit unit for each canonical byte unit name (eg. "kB", "kb").
+func newBitUnitNames(names []string) []string {
+	result := make([]string, 0, 2*len(names))
+	for _, name := range names {
+		result = append(result, name, strings.TrimSuffix(name, "B")+"b")
+	}
+
+	return result
+}
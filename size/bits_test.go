@@ -0,0 +1,257 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestParseBits(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    uint64
+		wantErr error
+	}{
+		{
+			name: "bits",
+			size: "100b",
+			want: 100,
+		},
+		{
+			name: "bytes",
+			size: "100B",
+			want: 800,
+		},
+		{
+			name: "megabits",
+			size: "100Mb",
+			want: 100_000_000,
+		},
+		{
+			name: "longName/bits",
+			size: "1 kilobit",
+			want: 1000,
+		},
+		{
+			name: "longName/bytes",
+			size: "2 bytes",
+			want: 16,
+		},
+		{
+			name: "megabytes",
+			size: "1MB",
+			want: 8_000_000,
+		},
+		{
+			name: "kibibits",
+			size: "1Kibit",
+			want: 1024,
+		},
+		{
+			name: "mebibits",
+			size: "2Mibit",
+			want: 2 * 1024 * 1024,
+		},
+		{
+			name: "gigabits",
+			size: "1.5 Gbits",
+			want: 1_500_000_000,
+		},
+		{
+			name: "prefixCase",
+			size: "1kb",
+			want: 1000,
+		},
+		{
+			name: "fractional",
+			size: "0.1KiB",
+			want: 819,
+		},
+		{
+			name: "exbibyte",
+			size: "1EiB",
+			want: 1 << 63,
+		},
+		{
+			name:    "overflow",
+			size:    "3EB",
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "missingUnit",
+			size:    "512",
+			wantErr: ErrMissingUnit,
+		},
+		{
+			name:    "prefixOnly",
+			size:    "512M",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "doubleSuffix",
+			size:    "1bb",
+			wantErr: ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBits(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseBits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseBits() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBitAware(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     string
+		rounding Rounding
+		want     Size
+		wantErr  error
+	}{
+		{
+			name: "bytes",
+			size: "1MB",
+			want: MB,
+		},
+		{
+			name: "megabits",
+			size: "100Mb",
+			want: 12_500_000,
+		},
+		{
+			name: "longName/bytes",
+			size: "2 bytes",
+			want: 2,
+		},
+		{
+			name: "longName/byte",
+			size: "1 Byte",
+			want: 1,
+		},
+		{
+			name: "longName/megabits",
+			size: "100 megabits",
+			want: 12_500_000,
+		},
+		{
+			name: "longName/kibibytes",
+			size: "2 Kibibytes",
+			want: 2 * KiB,
+		},
+		{
+			name: "longName/exbibit",
+			size: "8 exbibits",
+			want: EiB,
+		},
+		{
+			name: "longName/bits",
+			size: "16 bits",
+			want: 2,
+		},
+		{
+			name:    "longName/unknownPrefix",
+			size:    "1 zettabyte",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name: "kibibits",
+			size: "8Kibit",
+			want: KiB,
+		},
+		{
+			name: "gigabits",
+			size: "1Gb",
+			want: 125 * MB,
+		},
+		{
+			name: "floor",
+			size: "12bit",
+			want: 1,
+		},
+		{
+			name:     "ceil",
+			size:     "9bits",
+			rounding: RoundCeil,
+			want:     2,
+		},
+		{
+			name:     "halfEven",
+			size:     "12b",
+			rounding: RoundHalfEven,
+			want:     2,
+		},
+		{
+			name:     "reject",
+			size:     "9b",
+			rounding: RoundReject,
+			wantErr:  ErrFractional,
+		},
+		{
+			name:     "exact/reject",
+			size:     "64b",
+			rounding: RoundReject,
+			want:     8,
+		},
+		{
+			name:     "precise",
+			size:     "1.0000000000000000001Gb",
+			rounding: RoundCeil,
+			want:     125*MB + 1,
+		},
+		{
+			name: "maxBits",
+			size: "64Eib",
+			want: 8 * EiB,
+		},
+		{
+			name:    "unknownUnit",
+			size:    "1Xb",
+			wantErr: ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBitAwareRounding(tt.size, tt.rounding)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseBitAwareRounding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseBitAwareRounding() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBitAware_Error(t *testing.T) {
+	_, err := ParseBitAware("1Mbyte")
+
+	want := "size: unit 'Mbyte' unknown, did you mean 'MB'? available units [B, b, kB, kb, KiB, Kib, " +
+		"MB, Mb, MiB, Mib, GB, Gb, GiB, Gib, TB, Tb, TiB, Tib, PB, Pb, PiB, Pib, EB, Eb, EiB, Eib]"
+	if err == nil || err.Error() != want {
+		t.Errorf("ParseBitAware() error = %v, want %s", err, want)
+	}
+}
+
+// Examples
+
+func ExampleParseBitAware() {
+	bandwidth, _ := ParseBitAware("100Mb")
+	bits, _ := ParseBits("1.5KiB")
+
+	fmt.Println(bandwidth.Human())
+	fmt.Println(bits)
+	// Output:
+	// 12.5MB
+	// 12288
+}
@@ -0,0 +1,129 @@
+package size
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseCompound parses a sum of sizes written one after another with or without spaces
+// (eg. "1GiB 512MiB", "1G512M"), like time.ParseDuration accepts "1h30m".
+// Each term follows the rules of Parse, all terms must use the same unit system.
+func ParseCompound(size string) (Size, error) {
+	return parseCompound(size, false)
+}
+
+// ParseCompoundMixed is like ParseCompound but allows terms in different unit systems (eg. "1GiB 500MB").
+func ParseCompoundMixed(size string) (Size, error) {
+	return parseCompound(size, true)
+}
+
+// FormatCompound returns the size as an exact sum of descending binary units
+// (eg. "1GiB 512MiB 3B"), the result parses back with ParseCompound to the same size.
+func FormatCompound(size Size) string {
+	if size == 0 {
+		return "0" + string(Byte)
+	}
+
+	var result strings.Builder
+	for i := len(binarySuffixes) - 1; i >= 0; i-- {
+		suffix := binarySuffixes[i]
+		if size < suffix.Unit {
+			continue
+		}
+
+		if result.Len() > 0 {
+			result.WriteByte(' ')
+		}
+
+		result.WriteString(strconv.FormatUint(uint64(size/suffix.Unit), 10))
+		result.WriteString(string(suffix.Suffix))
+		size %= suffix.Unit
+	}
+
+	return result.String()
+}
+
+func parseCompound[T string | []byte](input T, mixed bool) (Size, error) {
+	start, end := 0, len(input)
+	for start < end && isSpace(input[start]) {
+		start++
+	}
+
+	for end > start && isSpace(input[end-1]) {
+		end--
+	}
+
+	if start == end {
+		return 0, &ParseError{Input: string(input), Offset: start, Kind: ErrInvalidNumber}
+	}
+
+	var (
+		total        Size
+		binary, seen bool
+	)
+
+	for i := start; i < end; {
+		if input[i] == '-' {
+			return 0, &ParseError{Input: string(input), Offset: i, Kind: ErrNegative}
+		}
+
+		number, j, ok := scanDecimal(input[:end], i, false)
+		if !ok {
+			return 0, &ParseError{Input: string(input), Offset: i, Kind: ErrInvalidNumber}
+		}
+
+		if j < end && input[j] == ' ' {
+			j++
+		}
+
+		unitOffset := j
+		for j < end && isLetter(input[j]) {
+			j++
+		}
+
+		name := input[unitOffset:j]
+		if len(name) == 0 {
+			return 0, &ParseError{Input: string(input), Offset: unitOffset, Kind: ErrMissingUnit}
+		}
+
+		units, termBinary := decimalUnits, len(name) > 1 && toLower(name[1]) == 'i'
+		if termBinary {
+			units = binaryUnits
+		}
+
+		unit, exist := lookupUnit(name, units, true)
+		if suffix, long := lookupUnit(name, longNameSuffixes, false); !exist && long {
+			unit, exist = lookupLongName(name, allUnits, false)
+			termBinary = strings.Contains(string(suffix), "i")
+		}
+
+		if !exist {
+			return 0, unknownUnitError(string(input), unitOffset, string(name), unitNames(allUnits))
+		}
+
+		if unit != ByteBase {
+			if !mixed && seen && binary != termBinary {
+				return 0, &ParseError{Input: string(input), Offset: unitOffset, Kind: ErrMixedSystems, Unit: string(name)}
+			}
+
+			binary, seen = termBinary, true
+		}
+
+		value, err := number.scale(unit, DefaultRounding)
+		if err != nil {
+			return 0, &ParseError{Input: string(input), Offset: i, Kind: err}
+		}
+
+		if total += value; total < value {
+			return 0, &ParseError{Input: string(input), Offset: i, Kind: ErrOverflow}
+		}
+
+		for j < end && isSpace(input[j]) {
+			j++
+		}
+
+		i = j
+	}
+
+	return total, nil
+}
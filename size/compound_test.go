@@ -0,0 +1,185 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Tests
+
+func TestParseCompound(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    Size
+		wantErr error
+	}{
+		{
+			name: "binary/Spaces",
+			size: "1GiB 512MiB",
+			want: GiB + 512*MiB,
+		},
+		{
+			name: "decimal/NoSpaces",
+			size: "1G512M",
+			want: GB + 512*MB,
+		},
+		{
+			name: "binary/NoSpaces",
+			size: "1Gi512Mi",
+			want: GiB + 512*MiB,
+		},
+		{
+			name: "unitSpace",
+			size: " 1 GiB  512 MiB 3B ",
+			want: GiB + 512*MiB + 3,
+		},
+		{
+			name: "single",
+			size: "1.5GB",
+			want: 1500 * MB,
+		},
+		{
+			name: "bytesAreNeutral",
+			size: "1KiB 24B",
+			want: 1048,
+		},
+		{
+			name: "longNames/bytes",
+			size: "1GiB 512 bytes",
+			want: GiB + 512,
+		},
+		{
+			name: "longNames",
+			size: "1 gibibyte 512 mebibytes",
+			want: GiB + 512*MiB,
+		},
+		{
+			name: "longNames/exbi",
+			size: "1 exbibyte 1KiB",
+			want: EiB + KiB,
+		},
+		{
+			name:    "longNames/mixed",
+			size:    "1 gibibyte 500 megabytes",
+			wantErr: ErrMixedSystems,
+		},
+		{
+			name:    "mixed",
+			size:    "1GiB 500MB",
+			wantErr: ErrMixedSystems,
+		},
+		{
+			name:    "missingUnit",
+			size:    "1GiB 512",
+			wantErr: ErrMissingUnit,
+		},
+		{
+			name:    "unknownUnit",
+			size:    "1GiB 512XB",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "negative",
+			size:    "1GiB -512MiB",
+			wantErr: ErrNegative,
+		},
+		{
+			name:    "empty",
+			size:    " ",
+			wantErr: ErrInvalidNumber,
+		},
+		{
+			name:    "overflow",
+			size:    "15EiB 1EiB",
+			wantErr: ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCompound(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseCompound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCompound() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCompoundMixed(t *testing.T) {
+	got, err := ParseCompoundMixed("1GiB 500MB")
+	if err != nil {
+		t.Fatalf("ParseCompoundMixed() error = %v", err)
+	}
+	if want := GiB + 500*MB; got != want {
+		t.Errorf("ParseCompoundMixed() got = %v, want %v", got, want)
+	}
+}
+
+func TestFormatCompound(t *testing.T) {
+	tests := []struct {
+		name string
+		size Size
+		want string
+	}{
+		{
+			name: "zero",
+			size: 0,
+			want: "0B",
+		},
+		{
+			name: "Byte",
+			size: 512,
+			want: "512B",
+		},
+		{
+			name: "GibiByte",
+			size: 3 * GiB,
+			want: "3GiB",
+		},
+		{
+			name: "compound",
+			size: GiB + 512*MiB + 3,
+			want: "1GiB 512MiB 3B",
+		},
+		{
+			name: "decimal",
+			size: GB,
+			want: "953MiB 690KiB 512B",
+		},
+		{
+			name: "max",
+			size: math.MaxUint64,
+			want: "15EiB 1023PiB 1023TiB 1023GiB 1023MiB 1023KiB 1023B",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatCompound(tt.size)
+			if got != tt.want {
+				t.Errorf("FormatCompound() = %v, want %v", got, tt.want)
+			}
+
+			if parsed, err := ParseCompound(got); err != nil || parsed != tt.size {
+				t.Errorf("ParseCompound(%q) = %v, %v, want %v", got, parsed, err, tt.size)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleParseCompound() {
+	size, _ := ParseCompound("1G512M")
+
+	fmt.Println(uint64(size))
+	fmt.Println(FormatCompound(GiB + 512*MiB))
+	// Output:
+	// 1512000000
+	// 1GiB 512MiB
+}
@@ -0,0 +1,67 @@
+package size
+
+import (
+	"fmt"
+	"math"
+)
+
+// Int64 returns the size as int64 (eg. for os.File.Truncate or io.LimitReader)
+// or returns an error wrapping ErrOverflow if it does not fit.
+func (s Size) Int64() (int64, error) {
+	if s > math.MaxInt64 {
+		return 0, fmt.Errorf("size: %d bytes do not fit into int64: %w", uint64(s), ErrOverflow)
+	}
+
+	return int64(s), nil
+}
+
+// Int returns the size as int or returns an error wrapping ErrOverflow if it does not fit.
+func (s Size) Int() (int, error) {
+	if s > math.MaxInt {
+		return 0, fmt.Errorf("size: %d bytes do not fit into int: %w", uint64(s), ErrOverflow)
+	}
+
+	return int(s), nil
+}
+
+// Uint32 returns the size as uint32 or returns an error wrapping ErrOverflow if it does not fit.
+func (s Size) Uint32() (uint32, error) {
+	if s > math.MaxUint32 {
+		return 0, fmt.Errorf("size: %d bytes do not fit into uint32: %w", uint64(s), ErrOverflow)
+	}
+
+	return uint32(s), nil
+}
+
+// ParseInt64 parses the size like Parse and returns it as int64
+// or returns an error wrapping ErrOverflow if it does not fit.
+func ParseInt64(size string) (int64, error) {
+	value, err := Parse(size)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.Int64()
+}
+
+// ParseInt parses the size like Parse and returns it as int
+// or returns an error wrapping ErrOverflow if it does not fit.
+func ParseInt(size string) (int, error) {
+	value, err := Parse(size)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.Int()
+}
+
+// ParseUint32 parses the size like Parse and returns it as uint32
+// or returns an error wrapping ErrOverflow if it does not fit.
+func ParseUint32(size string) (uint32, error) {
+	value, err := Parse(size)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.Uint32()
+}
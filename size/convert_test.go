@@ -0,0 +1,216 @@
+package size
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse_Overflow(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) (uint64, error)
+		size  string
+	}{
+		{
+			name:  "ParseSize/PetaByte",
+			parse: ParseSize,
+			size:  "20000PB",
+		},
+		{
+			name:  "ParseSize/PebiByte",
+			parse: ParseSize,
+			size:  "99999PiB",
+		},
+		{
+			name:  "FromHumanSize",
+			parse: FromHumanSize,
+			size:  "18446744073709551616",
+		},
+		{
+			name:  "FromBinarySize",
+			parse: FromBinarySize,
+			size:  "16384PiB",
+		},
+		{
+			name: "FromSize",
+			parse: func(size string) (uint64, error) {
+				return FromSize(size, decimalUnits)
+			},
+			size: "18446.744073709551616PB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.size)
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%s() error = %v, want %v", tt.name, err, ErrOverflow)
+			}
+			if got != 0 {
+				t.Errorf("%s() got = %v, want 0", tt.name, got)
+			}
+		})
+	}
+}
+
+func TestSize_Int64(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    Size
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "GibiByte",
+			size: 2 * GiB,
+			want: 2 * 1024 * 1024 * 1024,
+		},
+		{
+			name: "MaxInt64",
+			size: math.MaxInt64,
+			want: math.MaxInt64,
+		},
+		{
+			name:    "Overflow",
+			size:    math.MaxInt64 + 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.size.Int64()
+			if (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, ErrOverflow)) {
+				t.Errorf("Int64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Int64() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Uint32(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    Size
+		want    uint32
+		wantErr bool
+	}{
+		{
+			name: "MebiByte",
+			size: 512 * MiB,
+			want: 512 * 1024 * 1024,
+		},
+		{
+			name: "MaxUint32",
+			size: math.MaxUint32,
+			want: math.MaxUint32,
+		},
+		{
+			name:    "Overflow",
+			size:    4 * GiB,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.size.Uint32()
+			if (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, ErrOverflow)) {
+				t.Errorf("Uint32() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Uint32() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInt64(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "TebiByte",
+			size: "10TiB",
+			want: 10 * 1024 * 1024 * 1024 * 1024,
+		},
+		{
+			name:    "Overflow",
+			size:    "10000PB",
+			wantErr: ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInt64(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseInt64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseInt64() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUint32(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    uint32
+		wantErr error
+	}{
+		{
+			name: "MebiByte",
+			size: "64MiB",
+			want: 64 * 1024 * 1024,
+		},
+		{
+			name:    "Overflow",
+			size:    "10GiB",
+			wantErr: ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUint32(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseUint32() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseUint32() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkParseInt64(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseInt64("32.5 GiB")
+	}
+}
+
+func BenchmarkParseInt(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseInt("32.5 GiB")
+	}
+}
+
+func BenchmarkParseUint32(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseUint32("32.5 MiB")
+	}
+}
@@ -0,0 +1,268 @@
+package size
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+const (
+	// RoundFloor discards fractional bytes.
+	RoundFloor Rounding = iota
+	// RoundCeil rounds fractional bytes up to the next whole byte.
+	RoundCeil
+	// RoundHalfEven rounds fractional bytes to the nearest whole byte, ties to even.
+	RoundHalfEven
+	// RoundReject fails to parse values that resolve to fractional bytes.
+	RoundReject
+)
+
+// Rounding defines the policy applied to values that resolve to a fractional number of bytes.
+type Rounding uint8
+
+// DefaultRounding is the policy used by the parse functions that take no Rounding for values
+// that resolve to a fractional number of bytes (eg. "0.1KiB").
+const DefaultRounding = RoundFloor
+
+// maxExponent is the largest magnitude of the exponent of a number.
+const maxExponent = 1000
+
+var pow10 = [...]uint64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// decimal is an exact representation of a non-negative number as mantissa * 10^exp / 2^shift.
+type decimal struct {
+	mantissa uint64
+	exp      int
+	shift    uint
+
+	// overflow reports that the mantissa does not fit into uint64, such values
+	// are scaled with math/big arithmetic from the source number held in text.
+	overflow bool
+	text     string
+}
+
+// parseDecimal parses a number as a whole (eg. "512", "0.5", "1_024", "1e3") without loss of precision.
+func parseDecimal[T string | []byte](s T) (decimal, bool) {
+	d, end, ok := scanDecimal(s, 0, false)
+	return d, ok && end == len(s)
+}
+
+// scanDecimal scans the longest number starting at s[i] and returns it with the offset just past it,
+// see the package documentation for the grammar, lenient enables thousands separators.
+func scanDecimal[T string | []byte](s T, i int, lenient bool) (decimal, int, bool) {
+	var d decimal
+
+	start := i
+
+	i, digits, ok := scanDigits(&d, s, i, false, lenient)
+	if !ok {
+		return d, i, false
+	}
+
+	if i < len(s) && s[i] == '.' {
+		var fraction int
+		if i, fraction, ok = scanDigits(&d, s, i+1, true, false); !ok {
+			return d, i, false
+		}
+
+		digits += fraction
+	}
+
+	if digits == 0 {
+		return d, i, false
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j, negative := i+1, false
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			negative = s[j] == '-'
+			j++
+		}
+
+		if j < len(s) && isDigit(s[j]) {
+			exp := 0
+			for ; j < len(s) && isDigit(s[j]); j++ {
+				if exp = exp*10 + int(s[j]-'0'); exp > maxExponent {
+					return d, j, false
+				}
+			}
+
+			if negative {
+				exp = -exp
+			}
+
+			d.exp += exp
+			i = j
+		}
+	}
+
+	if d.overflow {
+		d.text = string(s[start:i])
+	}
+
+	return d, i, true
+}
+
+// scanDigits accumulates the digits starting at s[i] into d and returns the offset just past them
+// with their count, underscores may separate digits and, in lenient mode, commas may separate
+// groups of three digits of the integer part.
+func scanDigits[T string | []byte](d *decimal, s T, i int, fraction, lenient bool) (int, int, bool) {
+	digits, group, commas := 0, 0, 0
+
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case isDigit(c):
+			digits++
+			group++
+
+			if fraction {
+				d.exp--
+			}
+
+			if d.overflow {
+				continue
+			}
+
+			hi, lo := bits.Mul64(d.mantissa, 10)
+			lo, carry := bits.Add64(lo, uint64(c-'0'), 0)
+			if hi != 0 || carry != 0 {
+				d.overflow = true
+				continue
+			}
+
+			d.mantissa = lo
+		case c == '_':
+			if i == 0 || !isDigit(s[i-1]) || i+1 >= len(s) || !isDigit(s[i+1]) {
+				return i, digits, false
+			}
+		case c == ',' && lenient && !fraction:
+			if group == 0 || group > 3 || (commas > 0 && group != 3) {
+				return i, digits, false
+			}
+
+			commas++
+			group = 0
+		default:
+			return i, digits, commas == 0 || group == 3
+		}
+	}
+
+	return i, digits, commas == 0 || group == 3
+}
+
+// scale returns the number of bytes in d units, resolving fractional bytes with the given Rounding.
+func (d decimal) scale(unit Size, rounding Rounding) (Size, error) {
+	if !d.overflow && d.exp > 0 && d.exp < len(pow10) {
+		if hi, lo := bits.Mul64(d.mantissa, pow10[d.exp]); hi == 0 {
+			d.mantissa, d.exp = lo, 0
+		}
+	}
+
+	if d.overflow || d.exp > 0 || -d.exp >= len(pow10) || pow10[-d.exp] > math.MaxUint64>>d.shift {
+		return d.scaleBig(unit, rounding)
+	}
+
+	hi, lo := bits.Mul64(d.mantissa, uint64(unit))
+
+	divisor := pow10[-d.exp] << d.shift
+	if hi >= divisor {
+		return 0, ErrOverflow
+	}
+
+	quotient, remainder := bits.Div64(hi, lo, divisor)
+	if remainder == 0 {
+		return Size(quotient), nil
+	}
+
+	switch rounding {
+	case RoundFloor:
+		return Size(quotient), nil
+	case RoundCeil:
+		return roundUp(quotient)
+	case RoundHalfEven:
+		if half := divisor - remainder; remainder > half || (remainder == half && quotient&1 == 1) {
+			return roundUp(quotient)
+		}
+
+		return Size(quotient), nil
+	}
+
+	return 0, ErrFractional
+}
+
+func (d decimal) scaleBig(unit Size, rounding Rounding) (Size, error) {
+	quotient, err := d.bigScale(new(big.Int).SetUint64(uint64(unit)), rounding)
+	if err != nil {
+		return 0, err
+	}
+
+	if !quotient.IsUint64() {
+		return 0, ErrOverflow
+	}
+
+	return Size(quotient.Uint64()), nil
+}
+
+// bigScale returns the number of bytes in d units of arbitrary size,
+// resolving fractional bytes with the given Rounding.
+func (d decimal) bigScale(unit *big.Int, rounding Rounding) (*big.Int, error) {
+	numerator := new(big.Int).SetUint64(d.mantissa)
+	if d.overflow {
+		numerator.SetString(mantissaDigits(d.text), 10)
+	}
+
+	numerator.Mul(numerator, unit)
+
+	denominator := big.NewInt(1)
+	if d.exp > 0 {
+		numerator.Mul(numerator, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.exp)), nil))
+	} else {
+		denominator.Exp(big.NewInt(10), big.NewInt(int64(-d.exp)), nil)
+	}
+
+	denominator.Lsh(denominator, d.shift)
+
+	quotient, remainder := numerator.QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient, nil
+	}
+
+	switch rounding {
+	case RoundFloor:
+	case RoundCeil:
+		quotient.Add(quotient, big.NewInt(1))
+	case RoundHalfEven:
+		cmp := remainder.Lsh(remainder, 1).Cmp(denominator)
+		if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	default:
+		return nil, ErrFractional
+	}
+
+	return quotient, nil
+}
+
+// mantissaDigits returns the digits of the mantissa of a number, dropping separators and the exponent.
+func mantissaDigits(number string) string {
+	var digits strings.Builder
+	for i := 0; i < len(number) && number[i] != 'e' && number[i] != 'E'; i++ {
+		if isDigit(number[i]) {
+			digits.WriteByte(number[i])
+		}
+	}
+
+	return digits.String()
+}
+
+func roundUp(quotient uint64) (Size, error) {
+	if quotient == math.MaxUint64 {
+		return 0, ErrOverflow
+	}
+
+	return Size(quotient + 1), nil
+}
@@ -0,0 +1,193 @@
+package size
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   decimal
+		wantOk bool
+	}{
+		{
+			name:   "integer",
+			number: "512",
+			want:   decimal{mantissa: 512},
+			wantOk: true,
+		},
+		{
+			name:   "fraction",
+			number: "32.75",
+			want:   decimal{mantissa: 3275, exp: -2},
+			wantOk: true,
+		},
+		{
+			name:   "trailingDot",
+			number: "32.",
+			want:   decimal{mantissa: 32},
+			wantOk: true,
+		},
+		{
+			name:   "long",
+			number: "184467440737095516160",
+			want:   decimal{mantissa: 1844674407370955161, overflow: true, text: "184467440737095516160"},
+			wantOk: true,
+		},
+		{
+			name:   "twoDots",
+			number: "1.2.3",
+		},
+		{
+			name:   "noDigits",
+			number: ".",
+		},
+		{
+			name:   "letters",
+			number: "1a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseDecimal(tt.number)
+			if ok != tt.wantOk {
+				t.Errorf("parseDecimal() ok = %v, wantOk %v", ok, tt.wantOk)
+				return
+			}
+			if ok && got != tt.want {
+				t.Errorf("parseDecimal() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Scale(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   string
+		unit     Size
+		rounding Rounding
+		want     Size
+		wantErr  error
+	}{
+		{
+			name:   "exact",
+			number: "8.000000000000001",
+			unit:   PB,
+			want:   8000000000000001,
+		},
+		{
+			name:   "beyondFloat64",
+			number: "9007199254740993",
+			unit:   ByteBase,
+			want:   9007199254740993,
+		},
+		{
+			name:   "longFraction",
+			number: "1.50000000000000000000000001",
+			unit:   KiB,
+			want:   1536,
+		},
+		{
+			name:     "floor",
+			number:   "0.1",
+			unit:     KiB,
+			rounding: RoundFloor,
+			want:     102,
+		},
+		{
+			name:     "ceil",
+			number:   "0.1",
+			unit:     KiB,
+			rounding: RoundCeil,
+			want:     103,
+		},
+		{
+			name:     "halfEven/Down",
+			number:   "2.5",
+			unit:     ByteBase,
+			rounding: RoundHalfEven,
+			want:     2,
+		},
+		{
+			name:     "halfEven/Up",
+			number:   "3.5",
+			unit:     ByteBase,
+			rounding: RoundHalfEven,
+			want:     4,
+		},
+		{
+			name:     "halfEven/Nearest",
+			number:   "0.6",
+			unit:     KiB,
+			rounding: RoundHalfEven,
+			want:     614,
+		},
+		{
+			name:     "halfEven/Big",
+			number:   "0.000000000000000000005",
+			unit:     PB,
+			rounding: RoundHalfEven,
+			want:     0,
+		},
+		{
+			name:     "ceil/Big",
+			number:   "0.000000000000000000005",
+			unit:     PB,
+			rounding: RoundCeil,
+			want:     1,
+		},
+		{
+			name:     "reject",
+			number:   "0.1",
+			unit:     KiB,
+			rounding: RoundReject,
+			wantErr:  ErrFractional,
+		},
+		{
+			name:     "reject/Whole",
+			number:   "0.5",
+			unit:     KiB,
+			rounding: RoundReject,
+			want:     512,
+		},
+		{
+			name:    "overflow",
+			number:  "20000",
+			unit:    PB,
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "overflow/Big",
+			number:  "184467440737095516160",
+			unit:    ByteBase,
+			wantErr: ErrOverflow,
+		},
+		{
+			name:     "overflow/Ceil",
+			number:   "18446744073709551615.5",
+			unit:     ByteBase,
+			rounding: RoundCeil,
+			wantErr:  ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			number, ok := parseDecimal(tt.number)
+			if !ok {
+				t.Fatalf("parseDecimal(%q) failed", tt.number)
+			}
+
+			got, err := number.scale(tt.unit, tt.rounding)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("scale() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("scale() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
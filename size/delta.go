@@ -0,0 +1,111 @@
+package size
+
+import (
+	"errors"
+	"math"
+)
+
+// Delta represents a signed change of a size in bytes (eg. a growth or a shrink amount).
+type Delta int64
+
+// ParseDelta parses a size with an optional explicit sign (eg. "+512MiB", "-1GB") like Parse,
+// the sign must be followed by the number, and it returns an error wrapping ErrOverflow
+// if the magnitude does not fit into Delta.
+func ParseDelta(delta string) (Delta, error) {
+	i := 0
+	for i < len(delta) && isSpace(delta[i]) {
+		i++
+	}
+
+	negative := false
+	if i < len(delta) && (delta[i] == '+' || delta[i] == '-') {
+		negative = delta[i] == '-'
+		i++
+
+		if i < len(delta) && isSpace(delta[i]) {
+			return 0, &ParseError{Input: delta, Offset: i, Kind: ErrInvalidNumber}
+		}
+	}
+
+	magnitude, err := Parse(delta[i:])
+	if err != nil {
+		var parseErr *ParseError
+		if errors.As(err, &parseErr) {
+			parseErr.Input, parseErr.Offset = delta, parseErr.Offset+i
+		}
+
+		return 0, err
+	}
+
+	switch {
+	case negative && magnitude == math.MaxInt64+1:
+		return math.MinInt64, nil
+	case magnitude > math.MaxInt64:
+		return 0, &ParseError{Input: delta, Offset: i, Kind: ErrOverflow}
+	case negative:
+		return -Delta(magnitude), nil
+	}
+
+	return Delta(magnitude), nil
+}
+
+// String returns the delta in the IEC binary system, always prefixed with its sign (eg. "+512MiB", "-1GiB").
+func (d Delta) String() string {
+	return d.sign() + FormatBinary(d.Abs().Bytes())
+}
+
+// Human returns the delta in the SI decimal system, always prefixed with its sign (eg. "+512MB", "-1GB").
+func (d Delta) Human() string {
+	return d.sign() + FormatHuman(d.Abs().Bytes())
+}
+
+// Abs returns the magnitude of the delta.
+func (d Delta) Abs() Size {
+	if d < 0 {
+		return Size(-(d + 1)) + 1
+	}
+
+	return Size(d)
+}
+
+func (d Delta) sign() string {
+	if d < 0 {
+		return "-"
+	}
+
+	return "+"
+}
+
+// Add returns the size changed by the delta, saturating at zero and at the largest Size instead of wrapping around.
+func (s Size) Add(d Delta) Size {
+	if d < 0 {
+		if shrink := d.Abs(); shrink < s {
+			return s - shrink
+		}
+
+		return 0
+	}
+
+	if grow := Size(d); grow < math.MaxUint64-s {
+		return s + grow
+	}
+
+	return math.MaxUint64
+}
+
+// Sub returns the delta that changes other into s, saturating at the range of Delta.
+func (s Size) Sub(other Size) Delta {
+	if s >= other {
+		if diff := s - other; diff <= math.MaxInt64 {
+			return Delta(diff)
+		}
+
+		return math.MaxInt64
+	}
+
+	if diff := other - s; diff <= math.MaxInt64+1 {
+		return -Delta(diff-1) - 1
+	}
+
+	return math.MinInt64
+}
@@ -0,0 +1,250 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Tests
+
+func TestParseDelta(t *testing.T) {
+	tests := []struct {
+		name    string
+		delta   string
+		want    Delta
+		wantErr error
+	}{
+		{
+			name:  "positive",
+			delta: "+512MiB",
+			want:  Delta(512 * MiB),
+		},
+		{
+			name:  "negative",
+			delta: "-1GB",
+			want:  -Delta(GB),
+		},
+		{
+			name:  "unsigned",
+			delta: "20kB",
+			want:  Delta(20 * KB),
+		},
+		{
+			name:  "whitespace",
+			delta: " -1.5 KiB ",
+			want:  -1536,
+		},
+		{
+			name:  "minInt64",
+			delta: "-8EiB",
+			want:  math.MinInt64,
+		},
+		{
+			name:    "overflow",
+			delta:   "+8EiB",
+			wantErr: ErrOverflow,
+		},
+		{
+			name:    "doubleSign",
+			delta:   "--1GB",
+			wantErr: ErrNegative,
+		},
+		{
+			name:    "spaceAfterMinus",
+			delta:   "- 1B",
+			wantErr: ErrInvalidNumber,
+		},
+		{
+			name:    "spaceAfterPlus",
+			delta:   " + 512MiB",
+			wantErr: ErrInvalidNumber,
+		},
+		{
+			name:    "unknownUnit",
+			delta:   "+1XB",
+			wantErr: ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDelta(tt.delta)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseDelta() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDelta() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDelta_ErrorOffset(t *testing.T) {
+	_, err := ParseDelta("+1XB")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("ParseDelta() error = %#v, want *ParseError", err)
+	}
+	if parseErr.Input != "+1XB" || parseErr.Offset != 2 {
+		t.Errorf("ParseDelta() error input = %q offset = %d, want %q offset = %d", parseErr.Input, parseErr.Offset, "+1XB", 2)
+	}
+}
+
+func TestParseDelta_SeparatedSign(t *testing.T) {
+	_, err := ParseDelta(" - 1B")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("ParseDelta() error = %#v, want *ParseError", err)
+	}
+	if parseErr.Kind != ErrInvalidNumber || parseErr.Offset != 2 {
+		t.Errorf("ParseDelta() error kind = %v offset = %d, want %v offset = %d", parseErr.Kind, parseErr.Offset, ErrInvalidNumber, 2)
+	}
+}
+
+func TestDelta_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		delta Delta
+		want  string
+		human string
+	}{
+		{
+			name:  "positive",
+			delta: Delta(512 * MiB),
+			want:  "+512MiB",
+			human: "+536.9MB",
+		},
+		{
+			name:  "negative",
+			delta: -Delta(GB),
+			want:  "-953.7MiB",
+			human: "-1GB",
+		},
+		{
+			name:  "zero",
+			delta: 0,
+			want:  "+0B",
+			human: "+0B",
+		},
+		{
+			name:  "minInt64",
+			delta: math.MinInt64,
+			want:  "-8EiB",
+			human: "-9.223EB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.delta.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			if got := tt.delta.Human(); got != tt.human {
+				t.Errorf("Human() = %v, want %v", got, tt.human)
+			}
+		})
+	}
+}
+
+func TestSize_Add(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  Size
+		delta Delta
+		want  Size
+	}{
+		{
+			name:  "grow",
+			size:  GiB,
+			delta: Delta(512 * MiB),
+			want:  GiB + 512*MiB,
+		},
+		{
+			name:  "shrink",
+			size:  GiB,
+			delta: -Delta(512 * MiB),
+			want:  512 * MiB,
+		},
+		{
+			name:  "shrink/Saturate",
+			size:  512 * MiB,
+			delta: -Delta(GiB),
+			want:  0,
+		},
+		{
+			name:  "shrink/MinInt64",
+			size:  math.MaxUint64,
+			delta: math.MinInt64,
+			want:  math.MaxUint64 - math.MaxInt64 - 1,
+		},
+		{
+			name:  "grow/Saturate",
+			size:  math.MaxUint64 - KiB,
+			delta: Delta(MiB),
+			want:  math.MaxUint64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.size.Add(tt.delta); got != tt.want {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Sub(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  Size
+		other Size
+		want  Delta
+	}{
+		{
+			name:  "growth",
+			size:  GiB,
+			other: 512 * MiB,
+			want:  Delta(512 * MiB),
+		},
+		{
+			name:  "shrink",
+			size:  512 * MiB,
+			other: GiB,
+			want:  -Delta(512 * MiB),
+		},
+		{
+			name:  "saturate/Max",
+			size:  math.MaxUint64,
+			other: 0,
+			want:  math.MaxInt64,
+		},
+		{
+			name:  "saturate/Min",
+			size:  0,
+			other: math.MaxUint64,
+			want:  math.MinInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.size.Sub(tt.other); got != tt.want {
+				t.Errorf("Sub() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleParseDelta() {
+	delta, _ := ParseDelta("-512MiB")
+
+	fmt.Println(delta)
+	fmt.Println((2 * GiB).Add(delta))
+	// Output:
+	// -512MiB
+	// 1.5GiB
+}
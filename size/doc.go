@@ -0,0 +1,23 @@
+// Package size converts amounts of data between bytes and human-readable strings
+// using the SI decimal prefixes (kB, MB, GB, ...) and the IEC binary prefixes (KiB, MiB, GiB, ...).
+//
+// # Syntax
+//
+// A size is a number followed by an optional single space and a unit,
+// leading and trailing whitespace is ignored. Numbers follow the grammar:
+//
+//	number   = mantissa [ exponent ] .
+//	mantissa = digits [ "." [ digits ] ] | "." digits .
+//	digits   = digit { [ "_" ] digit } .
+//	exponent = ( "e" | "E" ) [ "+" | "-" ] digit { digit } .
+//	digit    = "0" … "9" .
+//
+// An "e" or "E" that is not followed by a digit or a sign and a digit starts the unit,
+// so "1e3MB" is a thousand megabytes while "1EB" is one exabyte.
+//
+// A Parser created WithLenient also accepts the integer part of the mantissa grouping
+// its digits in threes with commas (eg. "1,048,576B"):
+//
+//	grouped  = digit [ digit [ digit ] ] "," group { "," group } .
+//	group    = digit digit digit .
+package size
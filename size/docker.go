@@ -0,0 +1,125 @@
+package size
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	dockerDecimalUnits = Units{"k": KB, "m": MB, "g": GB, "t": TB, "p": PB}
+
+	dockerBinaryUnits = Units{"k": KiB, "m": MiB, "g": GiB, "t": TiB, "p": PiB}
+
+	dockerDecimalNames = []string{"B", "kB", "MB", "GB", "TB", "PB"}
+
+	dockerBinaryNames = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+
+	dockerDecimalSuffixes = []Suffix{Byte, KiloByte, MegaByte, GigaByte, TeraByte, PetaByte, ExaByte, ZettaByte, YottaByte}
+
+	dockerBinarySuffixes = []Suffix{Byte, KibiByte, MebiByte, GibiByte, TebiByte, PebiByte, ExbiByte, ZebiByte, YobiByte}
+)
+
+// DockerFromHumanSize parses a size the way FromHumanSize of docker/go-units does: the prefix letter
+// selects a power of 1000 whether or not it is followed by 'b' or "ib" (eg. "32k", "32kb", "32KiB"
+// are 32000), units are case-insensitive, a single space may separate the number and the unit,
+// and fractional bytes are truncated.
+func DockerFromHumanSize(size string) (int64, error) {
+	return parseDocker(size, dockerDecimalUnits, dockerDecimalNames)
+}
+
+// DockerRAMInBytes parses a size the way RAMInBytes of docker/go-units does: the prefix letter
+// selects a power of 1024 whether or not it is followed by 'b' or "ib" (eg. "512m", "512MB", "512MiB"
+// are 512 MiB), units are case-insensitive, a single space may separate the number and the unit,
+// and fractional bytes are truncated.
+func DockerRAMInBytes(size string) (int64, error) {
+	return parseDocker(size, dockerBinaryUnits, dockerBinaryNames)
+}
+
+// DockerHumanSize returns a human-readable approximation of a size capped at 4 valid numbers
+// the way HumanSize of docker/go-units does (eg. "2.746MB", "796kB").
+func DockerHumanSize(size float64) string {
+	return DockerHumanSizeWithPrecision(size, 4)
+}
+
+// DockerHumanSizeWithPrecision is DockerHumanSize with the given number of valid numbers.
+func DockerHumanSizeWithPrecision(size float64, precision int) string {
+	size, suffix := dockerSizeAndSuffix(size, DecimalBase, dockerDecimalSuffixes)
+	return fmt.Sprintf("%.*g%s", precision, size, suffix)
+}
+
+// DockerBytesSize returns a human-readable size in binary units the way BytesSize
+// of docker/go-units does (eg. "44KiB", "17MiB").
+func DockerBytesSize(size float64) string {
+	size, suffix := dockerSizeAndSuffix(size, BinaryBase, dockerBinarySuffixes)
+	return fmt.Sprintf(FormatDefault, size, suffix)
+}
+
+// parseDocker splits the size at the last digit, dot or space as docker/go-units does,
+// the number is parsed exactly rather than as float64.
+func parseDocker(size string, units Units, available []string) (int64, error) {
+	sep := strings.LastIndexAny(size, "0123456789. ")
+	if sep == -1 {
+		return 0, &ParseError{Input: size, Offset: 0, Kind: ErrInvalidNumber}
+	}
+
+	number, suffix := size[:sep+1], size[sep+1:]
+	if size[sep] == ' ' {
+		number = size[:sep]
+	}
+
+	negative := false
+	if len(number) > 0 && (number[0] == '+' || number[0] == '-') {
+		negative, number = number[0] == '-', number[1:]
+	}
+
+	// strconv.ParseFloat of docker/go-units rejects digit separators
+	value, ok := parseDecimal(number)
+	if !ok || strings.IndexByte(number, '_') >= 0 {
+		return 0, &ParseError{Input: size, Offset: 0, Kind: ErrInvalidNumber}
+	}
+
+	if negative && (value.overflow || value.mantissa != 0) {
+		return 0, &ParseError{Input: size, Offset: 0, Kind: ErrNegative}
+	}
+
+	unit, exist := dockerUnit(strings.ToLower(suffix), units)
+	if !exist {
+		return 0, unknownUnitError(size, sep+1, suffix, available)
+	}
+
+	bytes, err := value.scale(unit, RoundFloor)
+	if err == nil && bytes > math.MaxInt64 {
+		err = ErrOverflow
+	}
+
+	if err != nil {
+		return 0, &ParseError{Input: size, Offset: 0, Kind: err}
+	}
+
+	return int64(bytes), nil
+}
+
+// dockerUnit returns the unit of a lowercase suffix: nothing or "b" for bytes,
+// otherwise a prefix letter optionally followed by "b" or "ib".
+func dockerUnit(suffix string, units Units) (Size, bool) {
+	switch {
+	case suffix == "" || suffix == "b":
+		return ByteBase, true
+	case len(suffix) == 2 && suffix[1] != 'b', len(suffix) == 3 && suffix[1:] != "ib", len(suffix) > 3:
+		return 0, false
+	}
+
+	unit, exist := units[suffix[:1]]
+	return unit, exist
+}
+
+func dockerSizeAndSuffix(size float64, base float64, suffixes []Suffix) (float64, Suffix) {
+	i := 0
+	for size >= base && i < len(suffixes)-1 {
+		size /= base
+		i++
+	}
+
+	return size, suffixes[i]
+}
@@ -0,0 +1,217 @@
+package size
+
+import (
+	"fmt"
+	"testing"
+)
+
+// dockerConformance is the behaviour documented by the tests of docker/go-units,
+// a missing want means the size is rejected.
+type dockerConformance []struct {
+	size string
+	want int64
+	err  bool
+}
+
+// Tests
+
+func TestDockerFromHumanSize(t *testing.T) {
+	tests := dockerConformance{
+		{size: "0", want: 0},
+		{size: "0b", want: 0},
+		{size: "0B", want: 0},
+		{size: "0 B", want: 0},
+		{size: "32", want: 32},
+		{size: "32b", want: 32},
+		{size: "32B", want: 32},
+		{size: "32k", want: 32 * int64(KB)},
+		{size: "32K", want: 32 * int64(KB)},
+		{size: "32kb", want: 32 * int64(KB)},
+		{size: "32Kb", want: 32 * int64(KB)},
+		{size: "32Mb", want: 32 * int64(MB)},
+		{size: "32Gb", want: 32 * int64(GB)},
+		{size: "32Tb", want: 32 * int64(TB)},
+		{size: "32Pb", want: 32 * int64(PB)},
+		{size: "32KiB", want: 32 * int64(KB)},
+
+		{size: "32.5kB", want: 32500},
+		{size: "32.5 kB", want: 32500},
+		{size: "32.5 B", want: 32},
+		{size: "0.3 K", want: 300},
+		{size: ".3kB", want: 300},
+
+		{size: "0.", want: 0},
+		{size: "0. ", want: 0},
+		{size: "0.b", want: 0},
+		{size: "0.B", want: 0},
+		{size: "-0", want: 0},
+		{size: "-0b", want: 0},
+		{size: "-0B", want: 0},
+		{size: "-0 b", want: 0},
+		{size: "-0 B", want: 0},
+		{size: "32.", want: 32},
+		{size: "32.b", want: 32},
+		{size: "32.B", want: 32},
+		{size: "32. b", want: 32},
+		{size: "32. B", want: 32},
+		{size: "0 ", want: 0},
+
+		{size: " 0", err: true},
+		{size: " 0b", err: true},
+		{size: " 0B", err: true},
+		{size: " 0 B", err: true},
+		{size: "0b ", err: true},
+		{size: "0B ", err: true},
+		{size: "0 B ", err: true},
+
+		{size: "", err: true},
+		{size: "hello", err: true},
+		{size: ".", err: true},
+		{size: ". ", err: true},
+		{size: " ", err: true},
+		{size: "  ", err: true},
+		{size: " .", err: true},
+		{size: " . ", err: true},
+		{size: "-32", err: true},
+		{size: "-32b", err: true},
+		{size: "-32B", err: true},
+		{size: "-32 b", err: true},
+		{size: "-32 B", err: true},
+		{size: "32b.", err: true},
+		{size: "32B.", err: true},
+		{size: "32 b.", err: true},
+		{size: "32 B.", err: true},
+		{size: "32 bb", err: true},
+		{size: "32 BB", err: true},
+		{size: "32 b b", err: true},
+		{size: "32 B B", err: true},
+		{size: "32  b", err: true},
+		{size: "32  B", err: true},
+		{size: " 32 ", err: true},
+		{size: "32m b", err: true},
+		{size: "32bm", err: true},
+		{size: "32E", err: true},
+		{size: "1_000", err: true},
+		{size: "1_000kB", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.size, func(t *testing.T) {
+			got, err := DockerFromHumanSize(tt.size)
+			if (err != nil) != tt.err {
+				t.Errorf("DockerFromHumanSize() error = %v, wantErr %v", err, tt.err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("DockerFromHumanSize() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDockerRAMInBytes(t *testing.T) {
+	tests := dockerConformance{
+		{size: "32", want: 32},
+		{size: "32b", want: 32},
+		{size: "32B", want: 32},
+		{size: "32k", want: 32 * int64(KiB)},
+		{size: "32K", want: 32 * int64(KiB)},
+		{size: "32kb", want: 32 * int64(KiB)},
+		{size: "32Kb", want: 32 * int64(KiB)},
+		{size: "32Kib", want: 32 * int64(KiB)},
+		{size: "32KIB", want: 32 * int64(KiB)},
+		{size: "32Mb", want: 32 * int64(MiB)},
+		{size: "32Gb", want: 32 * int64(GiB)},
+		{size: "32Tb", want: 32 * int64(TiB)},
+		{size: "32Pb", want: 32 * int64(PiB)},
+		{size: "32PB", want: 32 * int64(PiB)},
+		{size: "32P", want: 32 * int64(PiB)},
+		{size: "512m", want: 512 * int64(MiB)},
+		{size: "2g", want: 2 * int64(GiB)},
+		{size: "1k", want: int64(KiB)},
+
+		{size: "32.3", want: 32},
+		{size: "32.3 mb", want: 33869004},
+		{size: "0.3MB", want: 314572},
+
+		{size: "", err: true},
+		{size: "hello", err: true},
+		{size: "-32", err: true},
+		{size: "32.3Kibb", err: true},
+		{size: "32.3 Kibb", err: true},
+		{size: "32.3 Kib b", err: true},
+		{size: "8192P", err: true},
+		{size: "1_000", err: true},
+		{size: "1_024 MiB", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.size, func(t *testing.T) {
+			got, err := DockerRAMInBytes(tt.size)
+			if (err != nil) != tt.err {
+				t.Errorf("DockerRAMInBytes() error = %v, wantErr %v", err, tt.err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("DockerRAMInBytes() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDockerHumanSize(t *testing.T) {
+	tests := []struct {
+		size float64
+		want string
+	}{
+		{size: 1000, want: "1kB"},
+		{size: 1024, want: "1.024kB"},
+		{size: 1000000, want: "1MB"},
+		{size: 1048576, want: "1.049MB"},
+		{size: float64(2 * MB), want: "2MB"},
+		{size: 3.42 * float64(GB), want: "3.42GB"},
+		{size: 5.372 * float64(TB), want: "5.372TB"},
+		{size: 2.22 * float64(PB), want: "2.22PB"},
+		{size: 10000000000000 * float64(PB), want: "1e+04YB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := DockerHumanSize(tt.size); got != tt.want {
+				t.Errorf("DockerHumanSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDockerBytesSize(t *testing.T) {
+	tests := []struct {
+		size float64
+		want string
+	}{
+		{size: 1024, want: "1KiB"},
+		{size: 1024 * 1024, want: "1MiB"},
+		{size: 1048576, want: "1MiB"},
+		{size: float64(2 * MiB), want: "2MiB"},
+		{size: 3.42 * float64(GiB), want: "3.42GiB"},
+		{size: 5.372 * float64(TiB), want: "5.372TiB"},
+		{size: 2.22 * float64(PiB), want: "2.22PiB"},
+		{size: float64(KiB) * float64(KiB) * float64(KiB) * float64(KiB) * float64(KiB) * float64(PiB), want: "1.049e+06YiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := DockerBytesSize(tt.size); got != tt.want {
+				t.Errorf("DockerBytesSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleDockerRAMInBytes() {
+	fmt.Println(DockerRAMInBytes("512m"))
+	fmt.Println(DockerFromHumanSize("512m"))
+	fmt.Println(DockerBytesSize(512 * float64(MiB)))
+	// Output:
+	// 536870912 <nil>
+	// 512000000 <nil>
+	// 512MiB
+}
@@ -0,0 +1,117 @@
+package size
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// EncodingBinary encodes sizes as IEC strings (eg. "512MiB").
+	EncodingBinary Encoding = iota
+	// EncodingDecimal encodes sizes as SI strings (eg. "512MB").
+	EncodingDecimal
+	// EncodingBytes encodes sizes as a raw integer byte count.
+	EncodingBytes
+)
+
+// Encoding defines how a Size is represented when marshalled.
+type Encoding uint8
+
+// MarshalEncoding is the representation used by Size.MarshalText and Size.MarshalJSON.
+// String encodings always use the largest unit that represents the size exactly,
+// so the marshalled value parses back to the same number of bytes.
+var MarshalEncoding = EncodingBinary
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Size) MarshalText() ([]byte, error) {
+	switch MarshalEncoding {
+	case EncodingBinary:
+		return []byte(formatExact(s, binarySuffixes)), nil
+	case EncodingDecimal:
+		return []byte(formatExact(s, decimalSuffixes)), nil
+	case EncodingBytes:
+		return strconv.AppendUint(nil, uint64(s), 10), nil
+	}
+
+	return nil, fmt.Errorf("size: encoding '%d' unknown", MarshalEncoding)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler,
+// a bare integer is treated as a number of bytes.
+func (s *Size) UnmarshalText(text []byte) error {
+	if value, err := strconv.ParseUint(string(text), 10, 64); err == nil {
+		*s = Size(value)
+		return nil
+	}
+
+	value, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = value
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Size) MarshalJSON() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	if MarshalEncoding == EncodingBytes {
+		return text, nil
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts both a quoted size string and
+// a bare number of bytes, which may be written with decimals or an exponent (eg. 1024.0, 1e6)
+// as long as it is a whole number.
+func (s *Size) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return fmt.Errorf("size: unmarshal json failed: %w", err)
+		}
+
+		return s.UnmarshalText([]byte(text))
+	}
+
+	if bytes.HasPrefix(data, []byte("-")) {
+		return &ParseError{Input: string(data), Kind: ErrNegative}
+	}
+
+	number, ok := parseDecimal(data)
+	if !ok {
+		return &ParseError{Input: string(data), Kind: ErrInvalidNumber}
+	}
+
+	value, err := number.scale(ByteBase, RoundReject)
+	if err != nil {
+		return &ParseError{Input: string(data), Kind: err}
+	}
+
+	*s = value
+	return nil
+}
+
+// formatExact returns the size in the largest unit of the given
+// Suffixes specification that divides it without a remainder.
+func formatExact(size Size, suffixes Suffixes) string {
+	for i := len(suffixes) - 1; i > 0; i-- {
+		if size != 0 && size%suffixes[i].Unit == 0 {
+			return strconv.FormatUint(uint64(size/suffixes[i].Unit), 10) + string(suffixes[i].Suffix)
+		}
+	}
+
+	return strconv.FormatUint(uint64(size), 10) + string(suffixes[0].Suffix)
+}
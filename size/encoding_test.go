@@ -0,0 +1,267 @@
+package size
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestSize_MarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		size     Size
+		want     string
+	}{
+		{
+			name:     "binary/MebiByte",
+			encoding: EncodingBinary,
+			size:     512 * MiB,
+			want:     "512MiB",
+		},
+		{
+			name:     "binary/Exact",
+			encoding: EncodingBinary,
+			size:     1536 * MiB,
+			want:     "1536MiB",
+		},
+		{
+			name:     "binary/Byte",
+			encoding: EncodingBinary,
+			size:     1000,
+			want:     "1000B",
+		},
+		{
+			name:     "decimal/MegaByte",
+			encoding: EncodingDecimal,
+			size:     512 * MB,
+			want:     "512MB",
+		},
+		{
+			name:     "decimal/Byte",
+			encoding: EncodingDecimal,
+			size:     1234567,
+			want:     "1234567B",
+		},
+		{
+			name:     "bytes",
+			encoding: EncodingBytes,
+			size:     512 * MiB,
+			want:     "536870912",
+		},
+		{
+			name:     "zero",
+			encoding: EncodingBinary,
+			size:     0,
+			want:     "0B",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding Encoding) { MarshalEncoding = encoding }(MarshalEncoding)
+			MarshalEncoding = tt.encoding
+
+			got, err := tt.size.MarshalText()
+			if err != nil {
+				t.Errorf("MarshalText() error = %v", err)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() got = %s, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Size
+		wantErr bool
+	}{
+		{
+			name: "binary",
+			text: "512MiB",
+			want: 512 * MiB,
+		},
+		{
+			name: "decimal",
+			text: "512MB",
+			want: 512 * MB,
+		},
+		{
+			name: "bytes",
+			text: "4096",
+			want: 4096,
+		},
+		{
+			name:    "invalid",
+			text:    "lots",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Size
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		size     Size
+		want     string
+	}{
+		{
+			name:     "binary",
+			encoding: EncodingBinary,
+			size:     512 * MiB,
+			want:     `"512MiB"`,
+		},
+		{
+			name:     "decimal",
+			encoding: EncodingDecimal,
+			size:     512 * MB,
+			want:     `"512MB"`,
+		},
+		{
+			name:     "bytes",
+			encoding: EncodingBytes,
+			size:     512 * MiB,
+			want:     `536870912`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding Encoding) { MarshalEncoding = encoding }(MarshalEncoding)
+			MarshalEncoding = tt.encoding
+
+			got, err := json.Marshal(tt.size)
+			if err != nil {
+				t.Errorf("MarshalJSON() error = %v", err)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() got = %s, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Size
+		wantErr bool
+		kind    error
+	}{
+		{
+			name: "string",
+			data: `"512MiB"`,
+			want: 512 * MiB,
+		},
+		{
+			name: "number",
+			data: `536870912`,
+			want: 512 * MiB,
+		},
+		{
+			name: "null",
+			data: `null`,
+			want: 0,
+		},
+		{
+			name:    "negative",
+			data:    `-1`,
+			wantErr: true,
+		},
+		{
+			name:    "fraction",
+			data:    `1.5`,
+			wantErr: true,
+			kind:    ErrFractional,
+		},
+		{
+			name: "number/decimals",
+			data: `1024.0`,
+			want: KiB,
+		},
+		{
+			name: "number/exponent",
+			data: `1e6`,
+			want: MB,
+		},
+		{
+			name: "number/exponentFraction",
+			data: `1.5E3`,
+			want: 1500,
+		},
+		{
+			name:    "number/exponentFractional",
+			data:    `1e-1`,
+			wantErr: true,
+			kind:    ErrFractional,
+		},
+		{
+			name:    "number/overflow",
+			data:    `1e20`,
+			wantErr: true,
+			kind:    ErrOverflow,
+		},
+		{
+			name:    "unknown",
+			data:    `"512XB"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Size
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.kind != nil && !errors.Is(err, tt.kind) {
+				t.Errorf("UnmarshalJSON() error = %v, want %v", err, tt.kind)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleSize_UnmarshalJSON() {
+	var config struct {
+		Cache Size `json:"cache"`
+		Body  Size `json:"body"`
+	}
+
+	_ = json.Unmarshal([]byte(`{"cache": "512MiB", "body": 65536}`), &config)
+
+	fmt.Println(config.Cache, config.Body)
+
+	data, _ := json.Marshal(config)
+	fmt.Println(string(data))
+	// Output:
+	// 512MiB 64KiB
+	// {"cache":"512MiB","body":"64KiB"}
+}
@@ -0,0 +1,78 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidNumber is returned when the numeric part of a size is missing or malformed.
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrUnknownUnit is returned when the unit of a size is not supported.
+	ErrUnknownUnit = errors.New("unknown unit")
+	// ErrMissingUnit is returned when a size has no unit and one is required.
+	ErrMissingUnit = errors.New("missing unit")
+	// ErrOverflow is returned when a value does not fit into the requested integer type.
+	ErrOverflow = errors.New("value overflows")
+	// ErrNegative is returned when a size is negative.
+	ErrNegative = errors.New("negative value")
+	// ErrMixedSystems is returned when a compound size mixes decimal and binary units.
+	ErrMixedSystems = errors.New("mixed unit systems")
+	// ErrTooLarge is returned when a size exceeds the limit of a Parser.
+	ErrTooLarge = errors.New("value exceeds limit")
+	// ErrFractional is returned when a size resolves to fractional bytes and RoundReject is in effect.
+	ErrFractional = errors.New("value resolves to fractional bytes")
+
+	allUnits = mergeUnits(decimalUnits, binaryUnits)
+)
+
+// ParseError describes a size string that could not be parsed,
+// Kind holds one of the Err* sentinels and is matched by errors.Is.
+type ParseError struct {
+	// Input is the string being parsed.
+	Input string
+	// Offset is the byte offset in Input at which the problem was found.
+	Offset int
+	// Kind is the class of the problem, one of the Err* errors.
+	Kind error
+	// Unit is the offending unit when Kind is ErrUnknownUnit.
+	Unit string
+	// Available lists the supported units in canonical order when Kind is ErrUnknownUnit.
+	Available []string
+	// Suggestions lists the units Unit was most likely meant to be when Kind is ErrUnknownUnit.
+	Suggestions []string
+}
+
+// Error implements error.
+func (e *ParseError) Error() string {
+	if e.Kind == ErrUnknownUnit {
+		if len(e.Suggestions) > 0 {
+			return fmt.Sprintf("size: unit '%s' unknown, did you mean '%s'? available units [%s]",
+				e.Unit,
+				strings.Join(e.Suggestions, "' or '"),
+				strings.Join(e.Available, ", "),
+			)
+		}
+
+		return fmt.Sprintf("size: unit '%s' unknown, available units [%s]", e.Unit, strings.Join(e.Available, ", "))
+	}
+
+	return fmt.Sprintf("size: %v in '%s' at offset %d", e.Kind, e.Input, e.Offset)
+}
+
+// Unwrap returns Kind, so that errors.Is reports the class of the problem.
+func (e *ParseError) Unwrap() error {
+	return e.Kind
+}
+
+func mergeUnits(units ...Units) Units {
+	merged := Units{}
+	for _, list := range units {
+		for name, unit := range list {
+			merged[name] = unit
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,138 @@
+package size
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		parse      func(string) (Size, error)
+		size       string
+		want       error
+		wantOffset int
+		wantUnit   string
+	}{
+		{
+			name:       "Parse/UnknownUnit",
+			parse:      Parse,
+			size:       "512XB",
+			want:       ErrUnknownUnit,
+			wantOffset: 3,
+			wantUnit:   "XB",
+		},
+		{
+			name:       "Parse/InvalidNumber",
+			parse:      Parse,
+			size:       "1..2KB",
+			want:       ErrInvalidNumber,
+			wantOffset: 0,
+		},
+		{
+			name:       "Parse/Negative",
+			parse:      Parse,
+			size:       " -512MB",
+			want:       ErrNegative,
+			wantOffset: 1,
+		},
+		{
+			name:       "Parse/Overflow",
+			parse:      Parse,
+			size:       "20000PB",
+			want:       ErrOverflow,
+			wantOffset: 0,
+		},
+		{
+			name:       "ParseHuman/UnknownUnit",
+			parse:      ParseHuman,
+			size:       " 512X",
+			want:       ErrUnknownUnit,
+			wantOffset: 4,
+			wantUnit:   "X",
+		},
+		{
+			name:       "ParseBinary/UnknownUnit",
+			parse:      ParseBinary,
+			size:       "512MB",
+			want:       ErrUnknownUnit,
+			wantOffset: 3,
+			wantUnit:   "MB",
+		},
+		{
+			name:       "ParseBinary/InvalidNumber",
+			parse:      ParseBinary,
+			size:       "KiB",
+			want:       ErrInvalidNumber,
+			wantOffset: 0,
+		},
+		{
+			name: "ParseUnits/Fractional",
+			parse: func(size string) (Size, error) {
+				return ParseUnitsRounding(size, binaryUnits, RoundReject)
+			},
+			size:       "0.1KiB",
+			want:       ErrFractional,
+			wantOffset: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.parse(tt.size)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want %v", err, tt.want)
+				return
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Errorf("error = %#v, want *ParseError", err)
+				return
+			}
+			if parseErr.Input != tt.size {
+				t.Errorf("Input = %q, want %q", parseErr.Input, tt.size)
+			}
+			if parseErr.Offset != tt.wantOffset {
+				t.Errorf("Offset = %v, want %v", parseErr.Offset, tt.wantOffset)
+			}
+			if parseErr.Unit != tt.wantUnit {
+				t.Errorf("Unit = %q, want %q", parseErr.Unit, tt.wantUnit)
+			}
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ParseError
+		want string
+	}{
+		{
+			name: "UnknownUnit",
+			err: &ParseError{
+				Input:     "512XB",
+				Offset:    3,
+				Kind:      ErrUnknownUnit,
+				Unit:      "XB",
+				Available: []string{"b", "kb"},
+			},
+			want: "size: unit 'XB' unknown, available units [b, kb]",
+		},
+		{
+			name: "Overflow",
+			err: &ParseError{
+				Input: "20000PB",
+				Kind:  ErrOverflow,
+			},
+			want: "size: value overflows in '20000PB' at offset 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package size
+
+import "flag"
+
+// Set implements flag.Value, the value is parsed with Parse,
+// a bare integer is treated as a number of bytes.
+func (s *Size) Set(value string) error {
+	return s.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of the value type, it is required by spf13/pflag.Value.
+func (s *Size) Type() string {
+	return "size"
+}
+
+// Flag defines a Size flag with specified name, default value, and usage string
+// in the given flag set or in flag.CommandLine if it is nil.
+// The return value is the address of a Size variable that stores the value of the flag.
+func Flag(fs *flag.FlagSet, name string, def Size, usage string) *Size {
+	value := new(Size)
+	FlagVar(fs, value, name, def, usage)
+	return value
+}
+
+// FlagVar defines a Size flag with specified name, default value, and usage string
+// in the given flag set or in flag.CommandLine if it is nil.
+// The argument p points to a Size variable in which to store the value of the flag.
+func FlagVar(fs *flag.FlagSet, p *Size, name string, def Size, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	*p = def
+	fs.Var(p, name, usage)
+}
@@ -0,0 +1,81 @@
+package size
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// Tests
+
+func TestFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    Size
+		wantErr bool
+	}{
+		{
+			name: "default",
+			args: []string{},
+			want: 64 * KiB,
+		},
+		{
+			name: "binary",
+			args: []string{"--max-body=10MiB"},
+			want: 10 * MiB,
+		},
+		{
+			name: "decimal",
+			args: []string{"--max-body", "20MB"},
+			want: 20 * MB,
+		},
+		{
+			name: "bytes",
+			args: []string{"--max-body=4096"},
+			want: 4096,
+		},
+		{
+			name:    "invalid",
+			args:    []string{"--max-body=10XB"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+
+			got := Flag(fs, "max-body", 64*KiB, "maximum request body size")
+
+			err := fs.Parse(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && *got != tt.want {
+				t.Errorf("Flag() got = %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Type(t *testing.T) {
+	if got := new(Size).Type(); got != "size" {
+		t.Errorf("Type() = %v, want %v", got, "size")
+	}
+}
+
+// Examples
+
+func ExampleFlag() {
+	fs := flag.NewFlagSet("server", flag.ExitOnError)
+	maxBody := Flag(fs, "max-body", MiB, "maximum request body size")
+
+	_ = fs.Parse([]string{"--max-body=10MiB"})
+
+	fmt.Println(*maxBody)
+	// Output:
+	// 10MiB
+}
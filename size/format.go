@@ -0,0 +1,236 @@
+package size
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// defaultPrecision is the number of significant digits FormatWith uses when FormatOptions.Precision is not set.
+const defaultPrecision = 4
+
+// FormatOptions controls how FormatWith renders a size, the zero value formats
+// with 4 significant digits in binary units, rounded down, with no separator.
+type FormatOptions struct {
+	// System selects the units, one of Binary, Decimal or JEDEC, Binary if zero.
+	System System
+	// Precision is the number of significant digits or, if Fixed, the number of decimals,
+	// digits of the integer part are never dropped and bytes are never given decimals.
+	Precision int
+	// Fixed makes Precision the number of decimals (eg. "1.50GiB") rather than significant digits.
+	Fixed bool
+	// Rounding is applied to the last displayed digit, RoundReject rounds to nearest like RoundHalfEven.
+	Rounding Rounding
+	// Separator is placed between the number and the unit (eg. " " for "1.5 GiB").
+	Separator string
+	// TrimZeros drops the trailing zeros of the fraction (eg. "2GiB" rather than "2.00GiB").
+	TrimZeros bool
+	// MinUnit is the smallest unit to format with (eg. MiB for "0.5MiB" rather than "512KiB").
+	MinUnit Size
+	// MaxUnit is the largest unit to format with (eg. MiB for "2048MiB" rather than "2GiB"), unbounded if zero.
+	MaxUnit Size
+	// LongNames spells the unit out, singular only when the displayed value is 1 (eg. "1.5 gigabytes", "1 byte", "1.0 gibibyte"),
+	// the Separator is a space if not set.
+	LongNames bool
+	// Locale localizes the separators, the unit symbols and the long names (eg. "1,5 ГБ"), locale-neutral if nil.
+	Locale *Locale
+}
+
+// FormatWith returns a human-readable size according to the options (eg. "1.50 GiB", "2GiB").
+func FormatWith(size Size, options FormatOptions) string {
+	suffixes := options.suffixes()
+
+	i := 0
+	for i+1 < len(suffixes) && size >= suffixes[i+1].Unit {
+		i++
+	}
+
+	for {
+		number, decimals := options.round(size, suffixes[i].Unit)
+
+		// rounding may carry the number up to the next unit (eg. 1023.99KiB to "1MiB")
+		if i+1 < len(suffixes) {
+			limit := new(big.Int).SetUint64(uint64(suffixes[i+1].Unit / suffixes[i].Unit))
+			if number.Cmp(limit.Mul(limit, pow10Big(decimals))) >= 0 {
+				i++
+				continue
+			}
+		}
+
+		return options.label(options.render(number, decimals), suffixes[i].Suffix)
+	}
+}
+
+// label appends the separator and the unit to the rendered number.
+func (o FormatOptions) label(number string, suffix Suffix) string {
+	separator := o.Separator
+	if o.LongNames && separator == "" {
+		separator = " "
+	}
+
+	if o.Locale != nil {
+		return o.Locale.formatNumber(number) + separator + o.Locale.unit(suffix, number, o.LongNames)
+	}
+
+	if !o.LongNames {
+		return number + separator + string(suffix)
+	}
+
+	if englishPlural(number) == 0 {
+		return number + separator + suffix.Singular()
+	}
+
+	return number + separator + suffix.Plural()
+}
+
+// FormatExact returns the size in the largest unit that divides it evenly (eg. "1536MiB", "1234567B", "3GiB"),
+// so ParseSize reads it back to the same number of bytes. The system is Binary, Decimal or, for zero or
+// Binary|Decimal, the one giving the shorter string with Binary on a tie, JEDEC units are not used.
+func FormatExact(size Size, system System) string {
+	switch system & (Binary | Decimal) {
+	case Binary:
+		return formatExact(size, binarySuffixes)
+	case Decimal:
+		return formatExact(size, decimalSuffixes)
+	}
+
+	binary, decimal := formatExact(size, binarySuffixes), formatExact(size, decimalSuffixes)
+	if len(decimal) < len(binary) {
+		return decimal
+	}
+
+	return binary
+}
+
+// suffixes returns the suffixes of the system between MinUnit and MaxUnit.
+func (o FormatOptions) suffixes() Suffixes {
+	all := binarySuffixes
+	switch o.System {
+	case Decimal:
+		all = decimalSuffixes
+	case JEDEC:
+		all = jedecSuffixes
+	}
+
+	var suffixes Suffixes
+	for _, suffix := range all {
+		if suffix.Unit >= o.MinUnit && (o.MaxUnit == 0 || suffix.Unit <= o.MaxUnit) {
+			suffixes = append(suffixes, suffix)
+		}
+	}
+
+	if len(suffixes) == 0 {
+		return all[:1]
+	}
+
+	return suffixes
+}
+
+// round returns size / unit rounded to the displayed decimals, scaled by 10^decimals,
+// bytes are whole and never have decimals.
+func (o FormatOptions) round(size, unit Size) (*big.Int, int) {
+	if unit == ByteBase {
+		return new(big.Int).SetUint64(uint64(size)), 0
+	}
+
+	if o.Fixed {
+		decimals := o.Precision
+		if decimals < 0 {
+			decimals = 0
+		}
+
+		return o.scale(size, unit, decimals), decimals
+	}
+
+	precision := o.Precision
+	if precision <= 0 {
+		precision = defaultPrecision
+	}
+
+	decimals := precision - integerDigits(size, unit)
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	number := o.scale(size, unit, decimals)
+
+	// rounding may carry into a new digit (eg. 9.9996 to "10.000")
+	if decimals > 0 && len(number.String()) > precision {
+		decimals--
+		number = o.scale(size, unit, decimals)
+	}
+
+	return number, decimals
+}
+
+// scale returns size / unit * 10^decimals rounded to an integer.
+func (o FormatOptions) scale(size, unit Size, decimals int) *big.Int {
+	numerator := new(big.Int).SetUint64(uint64(size))
+	numerator.Mul(numerator, pow10Big(decimals))
+
+	divisor := new(big.Int).SetUint64(uint64(unit))
+
+	quotient, remainder := numerator.QuoRem(numerator, divisor, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	switch o.Rounding {
+	case RoundFloor:
+	case RoundCeil:
+		quotient.Add(quotient, big.NewInt(1))
+	default:
+		cmp := remainder.Lsh(remainder, 1).Cmp(divisor)
+		if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return quotient
+}
+
+// render places the decimal point into the number scaled by 10^decimals.
+func (o FormatOptions) render(number *big.Int, decimals int) string {
+	digits := number.String()
+	if decimals == 0 {
+		return digits
+	}
+
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	integer, fraction := digits[:len(digits)-decimals], digits[len(digits)-decimals:]
+	if o.TrimZeros {
+		fraction = strings.TrimRight(fraction, "0")
+	}
+
+	if fraction == "" {
+		return integer
+	}
+
+	return integer + "." + fraction
+}
+
+// integerDigits returns the position of the first significant digit of size / unit,
+// counted from the decimal point (eg. 3 for 512, 1 for 1.5, 0 for 0.5, -1 for 0.05).
+func integerDigits(size, unit Size) int {
+	if size == 0 {
+		return 1
+	}
+
+	if size >= unit {
+		return len(strconv.FormatUint(uint64(size/unit), 10))
+	}
+
+	digits := 0
+	for ; size < unit; size *= 10 {
+		digits--
+	}
+
+	return digits + 1
+}
+
+func pow10Big(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
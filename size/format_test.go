@@ -0,0 +1,281 @@
+package size
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestFormatWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    Size
+		options FormatOptions
+		want    string
+	}{
+		{
+			name: "default",
+			size: 1536 * MiB,
+			want: "1.500GiB",
+		},
+		{
+			name:    "fixed/separator",
+			size:    1536 * MiB,
+			options: FormatOptions{Precision: 2, Fixed: true, Separator: " "},
+			want:    "1.50 GiB",
+		},
+		{
+			name:    "trimZeros",
+			size:    2 * GiB,
+			options: FormatOptions{Precision: 2, TrimZeros: true},
+			want:    "2GiB",
+		},
+		{
+			name:    "keepZeros",
+			size:    2 * GiB,
+			options: FormatOptions{Precision: 2},
+			want:    "2.0GiB",
+		},
+		{
+			name:    "decimal",
+			size:    2500 * KB,
+			options: FormatOptions{System: Decimal, TrimZeros: true},
+			want:    "2.5MB",
+		},
+		{
+			name:    "jedec",
+			size:    16 * GiB,
+			options: FormatOptions{System: JEDEC, TrimZeros: true},
+			want:    "16GB",
+		},
+		{
+			name:    "floor",
+			size:    GiB + 999*MiB,
+			options: FormatOptions{Precision: 2},
+			want:    "1.9GiB",
+		},
+		{
+			name:    "ceil",
+			size:    GiB + 1,
+			options: FormatOptions{Precision: 2, Rounding: RoundCeil},
+			want:    "1.1GiB",
+		},
+		{
+			name:    "halfEven",
+			size:    1792 * MiB,
+			options: FormatOptions{Precision: 2, Rounding: RoundHalfEven},
+			want:    "1.8GiB",
+		},
+		{
+			name:    "carryDigit",
+			size:    10*GiB - 1,
+			options: FormatOptions{Precision: 4, Rounding: RoundHalfEven},
+			want:    "10.00GiB",
+		},
+		{
+			name:    "carryUnit",
+			size:    MiB - 1,
+			options: FormatOptions{Rounding: RoundCeil, TrimZeros: true},
+			want:    "1MiB",
+		},
+		{
+			name:    "integerDigitsKept",
+			size:    1023 * KiB,
+			options: FormatOptions{Precision: 2},
+			want:    "1023KiB",
+		},
+		{
+			name:    "minUnit",
+			size:    512 * KiB,
+			options: FormatOptions{MinUnit: MiB, TrimZeros: true},
+			want:    "0.5MiB",
+		},
+		{
+			name:    "maxUnit",
+			size:    2 * GiB,
+			options: FormatOptions{MaxUnit: MiB, TrimZeros: true},
+			want:    "2048MiB",
+		},
+		{
+			name:    "maxUnit/noCarry",
+			size:    GiB - 1,
+			options: FormatOptions{MaxUnit: MiB, Rounding: RoundCeil, TrimZeros: true},
+			want:    "1024MiB",
+		},
+		{
+			name:    "bytes",
+			size:    512,
+			options: FormatOptions{Precision: 2, Fixed: true, Separator: " "},
+			want:    "512 B",
+		},
+		{
+			name:    "bytes/default",
+			size:    512,
+			options: FormatOptions{},
+			want:    "512B",
+		},
+		{
+			name:    "bytes/one",
+			size:    1,
+			options: FormatOptions{},
+			want:    "1B",
+		},
+		{
+			name:    "bytes/decimal",
+			size:    999,
+			options: FormatOptions{System: Decimal, Precision: 2},
+			want:    "999B",
+		},
+		{
+			name:    "zero/default",
+			size:    0,
+			options: FormatOptions{},
+			want:    "0B",
+		},
+		{
+			name:    "zero",
+			size:    0,
+			options: FormatOptions{TrimZeros: true},
+			want:    "0B",
+		},
+		{
+			name:    "max",
+			size:    1<<64 - 1,
+			options: FormatOptions{Rounding: RoundHalfEven},
+			want:    "16.00EiB",
+		},
+		{
+			name:    "longNames",
+			size:    1536 * MiB,
+			options: FormatOptions{System: Binary, TrimZeros: true, LongNames: true},
+			want:    "1.5 gibibytes",
+		},
+		{
+			name:    "longNames/decimal",
+			size:    1500 * MB,
+			options: FormatOptions{System: Decimal, TrimZeros: true, LongNames: true},
+			want:    "1.5 gigabytes",
+		},
+		{
+			name:    "longNames/singular",
+			size:    1,
+			options: FormatOptions{TrimZeros: true, LongNames: true},
+			want:    "1 byte",
+		},
+		{
+			name:    "longNames/fixedOne",
+			size:    KiB,
+			options: FormatOptions{Precision: 1, Fixed: true, LongNames: true},
+			want:    "1.0 kibibyte",
+		},
+		{
+			name:    "longNames/oneByte",
+			size:    1,
+			options: FormatOptions{LongNames: true},
+			want:    "1 byte",
+		},
+		{
+			name:    "longNames/significantOne",
+			size:    GiB,
+			options: FormatOptions{LongNames: true},
+			want:    "1.000 gibibyte",
+		},
+		{
+			name:    "longNames/roundedToOne",
+			size:    GiB + 1,
+			options: FormatOptions{Precision: 1, Fixed: true, LongNames: true},
+			want:    "1.0 gibibyte",
+		},
+		{
+			name:    "longNames/separator",
+			size:    512 * KiB,
+			options: FormatOptions{TrimZeros: true, LongNames: true, Separator: "\u00a0"},
+			want:    "512\u00a0kibibytes",
+		},
+		{
+			name:    "longNames/zero",
+			size:    0,
+			options: FormatOptions{TrimZeros: true, LongNames: true},
+			want:    "0 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatWith(tt.size, tt.options); got != tt.want {
+				t.Errorf("FormatWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatExact(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   Size
+		system System
+		want   string
+	}{
+		{name: "binary", size: 1536 * MiB, system: Binary, want: "1536MiB"},
+		{name: "binary/whole", size: 3 * GiB, system: Binary, want: "3GiB"},
+		{name: "binary/bytes", size: 1000, system: Binary, want: "1000B"},
+		{name: "decimal", size: 1234567, system: Decimal, want: "1234567B"},
+		{name: "decimal/whole", size: 1500 * KB, system: Decimal, want: "1500kB"},
+		{name: "auto/binary", size: 1536 * MiB, want: "1536MiB"},
+		{name: "auto/decimal", size: 3 * GB, want: "3GB"},
+		{name: "auto/both", size: 3 * GiB, system: Binary | Decimal, want: "3GiB"},
+		{name: "auto/tie", size: 1024 * KB, want: "1024kB"},
+		{name: "jedecIgnored", size: 2 * KB, system: JEDEC, want: "2kB"},
+		{name: "zero", size: 0, want: "0B"},
+		{name: "max", size: 1<<64 - 1, system: Binary, want: "18446744073709551615B"},
+		{name: "largestUnit", size: 15 * EiB, system: Binary, want: "15EiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatExact(tt.size, tt.system)
+			if got != tt.want {
+				t.Errorf("FormatExact() = %v, want %v", got, tt.want)
+			}
+
+			if parsed, err := ParseSize(got); err != nil || Size(parsed) != tt.size {
+				t.Errorf("ParseSize(%q) = %v, %v, want %v", got, parsed, err, tt.size)
+			}
+		})
+	}
+}
+
+func TestFormatExact_RoundTrip(t *testing.T) {
+	sizes := []Size{1, 999, 1000, 1023, 1024, 1536 * MiB, 1234567, 10 * PB, 3*EiB + 1, 1<<64 - 1, 1<<64 - 1<<20}
+	for _, size := range sizes {
+		for _, system := range []System{Binary, Decimal, Binary | Decimal} {
+			got := FormatExact(size, system)
+			if parsed, err := ParseSize(got); err != nil || Size(parsed) != size {
+				t.Errorf("ParseSize(FormatExact(%d, %d)) = %v, %v, from %q", size, system, parsed, err, got)
+			}
+		}
+	}
+}
+
+// Examples
+
+func ExampleFormatWith() {
+	fmt.Println(FormatWith(1536*MiB, FormatOptions{Precision: 2, Fixed: true, Separator: " "}))
+	fmt.Println(FormatWith(2*GB, FormatOptions{System: Decimal, TrimZeros: true}))
+	fmt.Println(FormatWith(GiB+1, FormatOptions{Precision: 1, Fixed: true, Rounding: RoundCeil}))
+	fmt.Println(FormatWith(512*KiB, FormatOptions{TrimZeros: true, LongNames: true}))
+	// Output:
+	// 1.50 GiB
+	// 2GB
+	// 1.1GiB
+	// 512 kibibytes
+}
+
+func ExampleFormatExact() {
+	fmt.Println(FormatExact(1536*MiB, Binary))
+	fmt.Println(FormatExact(1234567, Decimal))
+	fmt.Println(FormatExact(3*GB, Binary|Decimal))
+	// Output:
+	// 1536MiB
+	// 1234567B
+	// 3GB
+}
@@ -0,0 +1,61 @@
+package size
+
+// JEDECKiloByte is the JEDEC spelling of the kilobyte, 1024 bytes, the larger JEDEC
+// units are spelled as their SI counterparts (eg. "MB", "GB").
+const JEDECKiloByte Suffix = "KB"
+
+var (
+	jedecSuffixes = Suffixes{
+		{
+			Unit:   ByteBase,
+			Suffix: Byte,
+		},
+		{
+			Unit:   KiB,
+			Suffix: JEDECKiloByte,
+		},
+		{
+			Unit:   MiB,
+			Suffix: MegaByte,
+		},
+		{
+			Unit:   GiB,
+			Suffix: GigaByte,
+		},
+		{
+			Unit:   TiB,
+			Suffix: TeraByte,
+		},
+		{
+			Unit:   PiB,
+			Suffix: PetaByte,
+		},
+		{
+			Unit:   EiB,
+			Suffix: ExaByte,
+		},
+	}
+
+	jedecParser = NewParser(WithAllowedSystems(JEDEC))
+)
+
+// FromJEDECSize returns an integer from a size using the JEDEC convention of memory vendors
+// and Windows, where "KB", "MB" and "GB" are powers of 1024 (eg. "512KB", "16GB"), or returns
+// an error if it fails, units are case-insensitive, and the 'b' suffix is optional.
+func FromJEDECSize(size string) (uint64, error) {
+	value, err := ParseJEDEC(size)
+	return uint64(value), err
+}
+
+// ParseJEDEC returns the Size from a size using the JEDEC convention of memory vendors
+// and Windows, where "KB", "MB" and "GB" are powers of 1024 (eg. "512KB", "16GB"), or returns
+// an error if it fails, units are case-insensitive, and the 'b' suffix is optional.
+func ParseJEDEC(size string) (Size, error) {
+	return jedecParser.Parse(size)
+}
+
+// FormatJEDEC returns a human-readable approximation of a size using the JEDEC convention,
+// where "KB", "MB" and "GB" are powers of 1024 (eg. "512KB", "1.5GB").
+func FormatJEDEC(unit uint64) string {
+	return FormatSize(FormatDefault, unit, jedecSuffixes)
+}
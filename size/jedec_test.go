@@ -0,0 +1,186 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestParseJEDEC(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    Size
+		wantErr error
+	}{
+		{
+			name: "bytes",
+			size: "512B",
+			want: 512,
+		},
+		{
+			name: "bareNumber",
+			size: "512",
+			want: 512,
+		},
+		{
+			name: "kilobytes",
+			size: "512KB",
+			want: 512 * KiB,
+		},
+		{
+			name: "kilobytes/lowerCase",
+			size: "512kb",
+			want: 512 * KiB,
+		},
+		{
+			name: "megabytes",
+			size: "4 MB",
+			want: 4 * MiB,
+		},
+		{
+			name: "gigabytes/optionalByte",
+			size: "16G",
+			want: 16 * GiB,
+		},
+		{
+			name: "fractional",
+			size: "1.5GB",
+			want: 1536 * MiB,
+		},
+		{
+			name: "terabytes",
+			size: "2TB",
+			want: 2 * TiB,
+		},
+		{
+			name:    "binaryUnit",
+			size:    "512KiB",
+			wantErr: ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJEDEC(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseJEDEC() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseJEDEC() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJEDEC_Error(t *testing.T) {
+	_, err := ParseJEDEC("1KiB")
+
+	want := "size: unit 'KiB' unknown, did you mean 'KB'? available units [B, KB, MB, GB, TB, PB, EB]"
+	if err == nil || err.Error() != want {
+		t.Errorf("ParseJEDEC() error = %v, want %s", err, want)
+	}
+}
+
+func TestParser_JEDEC(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		size    string
+		want    Size
+		wantErr error
+	}{
+		{
+			name:    "binary",
+			options: []Option{WithAllowedSystems(Binary | JEDEC)},
+			size:    "1KiB",
+			want:    KiB,
+		},
+		{
+			name:    "jedec",
+			options: []Option{WithAllowedSystems(Binary | JEDEC)},
+			size:    "1MB",
+			want:    MiB,
+		},
+		{
+			name:    "precedence",
+			options: []Option{WithAllowedSystems(Decimal | JEDEC)},
+			size:    "1GB",
+			want:    GiB,
+		},
+		{
+			name:    "caseSensitive/decimal",
+			options: []Option{WithAllowedSystems(Decimal | JEDEC), WithCaseSensitive()},
+			size:    "1kB",
+			want:    KB,
+		},
+		{
+			name:    "caseSensitive/jedec",
+			options: []Option{WithAllowedSystems(Decimal | JEDEC), WithCaseSensitive()},
+			size:    "1KB",
+			want:    KiB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(tt.options...).Parse(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parse() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatJEDEC(t *testing.T) {
+	tests := []struct {
+		name string
+		unit uint64
+		want string
+	}{
+		{
+			name: "bytes",
+			unit: 512,
+			want: "512B",
+		},
+		{
+			name: "kilobytes",
+			unit: uint64(512 * KiB),
+			want: "512KB",
+		},
+		{
+			name: "gigabytes",
+			unit: uint64(1536 * MiB),
+			want: "1.5GB",
+		},
+		{
+			name: "exabytes",
+			unit: uint64(8 * EiB),
+			want: "8EB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatJEDEC(tt.unit); got != tt.want {
+				t.Errorf("FormatJEDEC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleParseJEDEC() {
+	memory, _ := ParseJEDEC("16GB")
+
+	fmt.Println(memory)
+	fmt.Println(FormatJEDEC(memory.Bytes()))
+	// Output:
+	// 16GiB
+	// 16GB
+}
@@ -0,0 +1,185 @@
+package size
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DecimalSI is the format of quantities with SI suffixes (eg. "129M", "500k").
+	DecimalSI QuantityFormat = iota
+	// BinarySI is the format of quantities with IEC suffixes (eg. "123Mi", "1536Mi").
+	BinarySI
+	// DecimalExponent is the format of quantities with a decimal exponent (eg. "129e6").
+	DecimalExponent
+)
+
+// QuantityFormat is the format of a Kubernetes quantity, it is chosen by the suffix the quantity is written with.
+type QuantityFormat uint8
+
+// minQuantityExponent is the exponent of the smallest fraction Kubernetes keeps, smaller ones are rounded up.
+const minQuantityExponent = -9
+
+var (
+	// quantityDecimalSuffixes holds the suffix of the exponent 3*i+minQuantityExponent at index i.
+	quantityDecimalSuffixes = []string{"n", "u", "m", "", "k", "M", "G", "T", "P", "E"}
+
+	// quantityBinarySuffixes holds the suffix of the power 1024^i at index i.
+	quantityBinarySuffixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+
+	quantitySuffixNames = []string{"n", "u", "m", "k", "M", "G", "T", "P", "E", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+)
+
+// ParseQuantity parses a memory quantity in the Kubernetes grammar (eg. "128974848", "129e6", "129M",
+// "123Mi", "1.5Gi", "500k") and returns the Size or returns an error if it fails, suffixes are
+// case-sensitive, and fractional bytes are rounded up as Kubernetes does.
+func ParseQuantity(quantity string) (Size, error) {
+	number, unit, _, err := parseQuantity(quantity)
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := number.scale(unit, RoundCeil)
+	if err != nil {
+		return 0, &ParseError{Input: quantity, Offset: 0, Kind: err}
+	}
+
+	return value, nil
+}
+
+// FormatQuantity returns the canonical Kubernetes representation of the size in the given format
+// (eg. "1536Mi" in BinarySI, "129M" in DecimalSI, "129e6" in DecimalExponent).
+func FormatQuantity(size Size, format QuantityFormat) string {
+	return formatQuantity(strconv.FormatUint(uint64(size), 10), 0, format)
+}
+
+// CanonicalQuantity returns the string Kubernetes serialises a memory quantity as (eg. "1.5Gi" is "1536Mi",
+// "1000k" is "1M"), the format is kept from the quantity, and fractions below nano are rounded up.
+func CanonicalQuantity(quantity string) (string, error) {
+	number, unit, format, err := parseQuantity(quantity)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = number.scale(unit, RoundCeil); err != nil {
+		return "", &ParseError{Input: quantity, Offset: 0, Kind: err}
+	}
+
+	nano := new(big.Int).Exp(big.NewInt(10), big.NewInt(-minQuantityExponent), nil)
+
+	nanos, err := number.bigScale(nano.Mul(nano, new(big.Int).SetUint64(uint64(unit))), RoundCeil)
+	if err != nil {
+		return "", &ParseError{Input: quantity, Offset: 0, Kind: err}
+	}
+
+	return formatQuantity(nanos.String(), minQuantityExponent, format), nil
+}
+
+// parseQuantity splits a quantity into its number, the unit of its suffix and the format the suffix implies.
+func parseQuantity(quantity string) (decimal, Size, QuantityFormat, error) {
+	var number decimal
+
+	i, negative := 0, false
+	if i < len(quantity) && (quantity[i] == '+' || quantity[i] == '-') {
+		negative = quantity[i] == '-'
+		i++
+	}
+
+	start := i
+
+	i, digits, _ := scanDigits(&number, quantity, i, false, false)
+	if i < len(quantity) && quantity[i] == '.' {
+		var fraction int
+		i, fraction, _ = scanDigits(&number, quantity, i+1, true, false)
+		digits += fraction
+	}
+
+	suffix := quantity[i:]
+	if digits == 0 || strings.IndexByte(quantity[start:i], '_') >= 0 || (suffix != "" && !isLetter(suffix[0])) {
+		return number, 0, 0, &ParseError{Input: quantity, Offset: 0, Kind: ErrInvalidNumber}
+	}
+
+	if number.overflow {
+		number.text = quantity[start:i]
+	}
+
+	unit, format, ok := Size(ByteBase), DecimalSI, false
+	for k := 0; !ok && k < len(quantityDecimalSuffixes); k++ {
+		if ok = suffix == quantityDecimalSuffixes[k]; ok {
+			number.exp += 3*k + minQuantityExponent
+		}
+	}
+
+	for k := 1; !ok && k < len(quantityBinarySuffixes); k++ {
+		if ok = suffix == quantityBinarySuffixes[k]; ok {
+			unit, format = binarySuffixes[k].Unit, BinarySI
+		}
+	}
+
+	// a suffix is an exponent only if it is not one of the named suffixes (eg. "Ei")
+	if !ok && len(suffix) > 1 && (suffix[0] == 'e' || suffix[0] == 'E') {
+		exp, err := strconv.Atoi(suffix[1:])
+		if err == nil && (exp > maxExponent || exp < -maxExponent) {
+			return number, 0, 0, &ParseError{Input: quantity, Offset: i, Kind: ErrInvalidNumber}
+		}
+
+		if err == nil {
+			number.exp += exp
+			format, ok = DecimalExponent, true
+		}
+	}
+
+	if !ok {
+		return number, 0, 0, unknownUnitError(quantity, i, suffix, quantitySuffixNames)
+	}
+
+	if negative && (number.overflow || number.mantissa != 0) {
+		return number, 0, 0, &ParseError{Input: quantity, Offset: 0, Kind: ErrNegative}
+	}
+
+	return number, unit, format, nil
+}
+
+// formatQuantity renders digits * 10^exp the way Kubernetes canonicalises quantities: BinarySI is kept
+// for whole numbers of at least 1024 bytes, otherwise the exponent is the largest multiple of 3
+// that leaves an integer mantissa.
+func formatQuantity(digits string, exp int, format QuantityFormat) string {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return "0"
+	}
+
+	for strings.HasSuffix(digits, "0") {
+		digits = digits[:len(digits)-1]
+		exp++
+	}
+
+	if format == BinarySI && exp >= 0 {
+		value, err := strconv.ParseUint(digits+strings.Repeat("0", exp), 10, 64)
+		if err == nil && value >= BinaryBase {
+			power := 0
+			for ; power < len(quantityBinarySuffixes)-1 && value%BinaryBase == 0; power++ {
+				value /= BinaryBase
+			}
+
+			return strconv.FormatUint(value, 10) + quantityBinarySuffixes[power]
+		}
+	}
+
+	if pad := (exp%3 + 3) % 3; pad > 0 {
+		digits += strings.Repeat("0", pad)
+		exp -= pad
+	}
+
+	k := (exp - minQuantityExponent) / 3
+	if format != DecimalExponent && exp >= minQuantityExponent && k < len(quantityDecimalSuffixes) {
+		return digits + quantityDecimalSuffixes[k]
+	}
+
+	if exp == 0 {
+		return digits
+	}
+
+	return digits + "e" + strconv.Itoa(exp)
+}
@@ -0,0 +1,292 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity string
+		want     Size
+		wantErr  error
+	}{
+		{
+			name:     "plain",
+			quantity: "128974848",
+			want:     128974848,
+		},
+		{
+			name:     "exponent",
+			quantity: "129e6",
+			want:     129 * MB,
+		},
+		{
+			name:     "exponent/upperCase",
+			quantity: "129E6",
+			want:     129 * MB,
+		},
+		{
+			name:     "exponent/signed",
+			quantity: "12900e-2",
+			want:     129,
+		},
+		{
+			name:     "decimalSI",
+			quantity: "129M",
+			want:     129 * MB,
+		},
+		{
+			name:     "kilo",
+			quantity: "500k",
+			want:     500 * KB,
+		},
+		{
+			name:     "exa",
+			quantity: "1E",
+			want:     EB,
+		},
+		{
+			name:     "binarySI",
+			quantity: "123Mi",
+			want:     123 * MiB,
+		},
+		{
+			name:     "binarySI/fractional",
+			quantity: "1.5Gi",
+			want:     1536 * MiB,
+		},
+		{
+			name:     "milli/roundedUp",
+			quantity: "1500m",
+			want:     2,
+		},
+		{
+			name:     "trailingDot",
+			quantity: "5.",
+			want:     5,
+		},
+		{
+			name:     "leadingDot",
+			quantity: ".5Ki",
+			want:     512,
+		},
+		{
+			name:     "plusSign",
+			quantity: "+1Ki",
+			want:     KiB,
+		},
+		{
+			name:     "negativeZero",
+			quantity: "-0",
+			want:     0,
+		},
+		{
+			name:     "negative",
+			quantity: "-1Mi",
+			wantErr:  ErrNegative,
+		},
+		{
+			name:     "lowerCaseBinary",
+			quantity: "1ki",
+			wantErr:  ErrUnknownUnit,
+		},
+		{
+			name:     "byteSuffix",
+			quantity: "1MiB",
+			wantErr:  ErrUnknownUnit,
+		},
+		{
+			name:     "space",
+			quantity: "1 Mi",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "underscore",
+			quantity: "1_000",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "empty",
+			quantity: "",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "emptyExponent",
+			quantity: "1e",
+			wantErr:  ErrUnknownUnit,
+		},
+		{
+			name:     "overflow",
+			quantity: "20E",
+			wantErr:  ErrOverflow,
+		},
+		{
+			name:     "exbi",
+			quantity: "1Ei",
+			want:     EiB,
+		},
+		{
+			name:     "exbi/two",
+			quantity: "2Ei",
+			want:     2 * EiB,
+		},
+		{
+			name:     "exponent/signed",
+			quantity: "5e+3",
+			want:     5 * KB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuantity(tt.quantity)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseQuantity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuantity() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalQuantity(t *testing.T) {
+	tests := []struct {
+		quantity string
+		want     string
+	}{
+		{quantity: "128974848", want: "128974848"},
+		{quantity: "129e6", want: "129e6"},
+		{quantity: "129M", want: "129M"},
+		{quantity: "123Mi", want: "123Mi"},
+		{quantity: "1.5Gi", want: "1536Mi"},
+		{quantity: "500k", want: "500k"},
+		{quantity: "1000k", want: "1M"},
+		{quantity: "1500", want: "1500"},
+		{quantity: "1.5k", want: "1500"},
+		{quantity: "1e4", want: "10e3"},
+		{quantity: "1e0", want: "1"},
+		{quantity: "0.5", want: "500m"},
+		{quantity: "1024", want: "1024"},
+		{quantity: "1Ki", want: "1Ki"},
+		{quantity: "1024Ki", want: "1Mi"},
+		{quantity: "1.5Ki", want: "1536"},
+		{quantity: "0.5Ki", want: "512"},
+		{quantity: "0.1Ki", want: "102400m"},
+		{quantity: "1.0000000001", want: "1000000001n"},
+		{quantity: "0.0000000001", want: "1n"},
+		{quantity: "0Mi", want: "0"},
+		{quantity: "-0", want: "0"},
+		{quantity: "16E", want: "16E"},
+		{quantity: "1Ei", want: "1Ei"},
+		{quantity: "2Ei", want: "2Ei"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.quantity, func(t *testing.T) {
+			got, err := CanonicalQuantity(tt.quantity)
+			if err != nil {
+				t.Fatalf("CanonicalQuantity() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CanonicalQuantity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantity(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   Size
+		format QuantityFormat
+		want   string
+	}{
+		{
+			name:   "decimalSI",
+			size:   129 * MB,
+			format: DecimalSI,
+			want:   "129M",
+		},
+		{
+			name:   "decimalSI/notMultiple",
+			size:   128974848,
+			format: DecimalSI,
+			want:   "128974848",
+		},
+		{
+			name:   "binarySI",
+			size:   1536 * MiB,
+			format: BinarySI,
+			want:   "1536Mi",
+		},
+		{
+			name:   "binarySI/small",
+			size:   1000,
+			format: BinarySI,
+			want:   "1k",
+		},
+		{
+			name:   "binarySI/notMultiple",
+			size:   1500,
+			format: BinarySI,
+			want:   "1500",
+		},
+		{
+			name:   "binarySI/max",
+			size:   8 * EiB,
+			format: BinarySI,
+			want:   "8Ei",
+		},
+		{
+			name:   "decimalExponent",
+			size:   129 * MB,
+			format: DecimalExponent,
+			want:   "129e6",
+		},
+		{
+			name:   "zero",
+			size:   0,
+			format: BinarySI,
+			want:   "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatQuantity(tt.size, tt.format); got != tt.want {
+				t.Errorf("FormatQuantity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantity_RoundTrip(t *testing.T) {
+	sizes := []Size{0, 1, 1000, 1500, 1024, 129 * MB, 128974848, 1536 * MiB, 8 * EiB, 15 * EiB, 1<<64 - 1}
+	for _, size := range sizes {
+		for _, format := range []QuantityFormat{DecimalSI, BinarySI, DecimalExponent} {
+			formatted := FormatQuantity(size, format)
+			if got, err := ParseQuantity(formatted); err != nil || got != size {
+				t.Errorf("ParseQuantity(%q) = %d, %v, want %d", formatted, got, err, size)
+			}
+		}
+	}
+}
+
+// Examples
+
+func ExampleCanonicalQuantity() {
+	memory, _ := ParseQuantity("1.5Gi")
+	canonical, _ := CanonicalQuantity("1.5Gi")
+
+	fmt.Println(memory)
+	fmt.Println(canonical)
+	fmt.Println(FormatQuantity(memory, DecimalSI))
+	// Output:
+	// 1.5GiB
+	// 1536Mi
+	// 1610612736
+}
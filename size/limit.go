@@ -0,0 +1,200 @@
+package size
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Unlimited is the Limit without a bound, it is greater than any finite Limit.
+const Unlimited Limit = math.MaxUint64
+
+const (
+	// DialectMax spells Unlimited as cgroup v2 does, "max".
+	DialectMax Dialect = iota
+	// DialectInfinity spells Unlimited as systemd does, "infinity".
+	DialectInfinity
+	// DialectUnlimited spells Unlimited as ulimit does, "unlimited".
+	DialectUnlimited
+	// DialectMinusOne spells Unlimited as many APIs do, "-1".
+	DialectMinusOne
+)
+
+// Limit is an upper bound of a size that may be Unlimited, the largest Size is reserved
+// for Unlimited and a finite limit of that size is rejected with ErrOverflow when parsed.
+type Limit uint64
+
+// Dialect defines how Unlimited is spelled when a Limit is formatted.
+type Dialect uint8
+
+// LimitDialect is the spelling of Unlimited used by Limit.String, Limit.MarshalText and Limit.MarshalJSON.
+var LimitDialect = DialectMax
+
+var dialects = [...]string{
+	DialectMax:       "max",
+	DialectInfinity:  "infinity",
+	DialectUnlimited: "unlimited",
+	DialectMinusOne:  "-1",
+}
+
+// ParseLimit returns Unlimited for "max", "infinity", "unlimited" and "-1", case-insensitively,
+// and otherwise parses the Limit with Parse.
+func ParseLimit(limit string) (Limit, error) {
+	if isUnlimited(limit) {
+		return Unlimited, nil
+	}
+
+	value, err := Parse(limit)
+	if err != nil {
+		return 0, err
+	}
+
+	return finiteLimit(limit, value)
+}
+
+// IsUnlimited reports whether the limit has no bound.
+func (l Limit) IsUnlimited() bool {
+	return l == Unlimited
+}
+
+// Size returns the bound of the limit and true, or false if the limit is Unlimited.
+func (l Limit) Size() (Size, bool) {
+	return Size(l), !l.IsUnlimited()
+}
+
+// Allows reports whether the size does not exceed the limit.
+func (l Limit) Allows(size Size) bool {
+	return l.IsUnlimited() || size <= Size(l)
+}
+
+// String returns the limit as a Size or Unlimited spelled in LimitDialect.
+func (l Limit) String() string {
+	return l.Format(LimitDialect)
+}
+
+// Format returns the limit as a Size or Unlimited spelled in the given dialect.
+func (l Limit) Format(dialect Dialect) string {
+	if !l.IsUnlimited() {
+		return Size(l).String()
+	}
+
+	if int(dialect) < len(dialects) {
+		return dialects[dialect]
+	}
+
+	return dialects[DialectMax]
+}
+
+// MarshalText implements encoding.TextMarshaler, a finite limit is encoded as a Size.
+func (l Limit) MarshalText() ([]byte, error) {
+	if l.IsUnlimited() {
+		return []byte(l.Format(LimitDialect)), nil
+	}
+
+	return Size(l).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the spellings of Unlimited
+// and otherwise decodes the limit as a Size.
+func (l *Limit) UnmarshalText(text []byte) error {
+	if isUnlimited(string(text)) {
+		*l = Unlimited
+		return nil
+	}
+
+	var value Size
+	if err := value.UnmarshalText(text); err != nil {
+		return err
+	}
+
+	limit, err := finiteLimit(string(text), value)
+	if err != nil {
+		return err
+	}
+
+	*l = limit
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, Unlimited is encoded as a string
+// or, in DialectMinusOne, as the number -1.
+func (l Limit) MarshalJSON() ([]byte, error) {
+	if !l.IsUnlimited() {
+		return Size(l).MarshalJSON()
+	}
+
+	if LimitDialect == DialectMinusOne {
+		return []byte(dialects[DialectMinusOne]), nil
+	}
+
+	return json.Marshal(l.Format(LimitDialect))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts the spellings of Unlimited,
+// including the number -1, and otherwise decodes the limit as a Size.
+func (l *Limit) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if bytes.Equal(data, []byte(dialects[DialectMinusOne])) {
+		*l = Unlimited
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return fmt.Errorf("size: unmarshal json failed: %w", err)
+		}
+
+		return l.UnmarshalText([]byte(text))
+	}
+
+	var value Size
+	if err := value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	limit, err := finiteLimit(string(data), value)
+	if err != nil {
+		return err
+	}
+
+	*l = limit
+	return nil
+}
+
+// Set implements flag.Value, the value is parsed with ParseLimit,
+// a bare integer is treated as a number of bytes.
+func (l *Limit) Set(value string) error {
+	return l.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of the value type, it is required by spf13/pflag.Value.
+func (l *Limit) Type() string {
+	return "limit"
+}
+
+// finiteLimit returns the size as a finite Limit, or ErrOverflow if it is the size reserved for Unlimited.
+func finiteLimit(input string, size Size) (Limit, error) {
+	if Limit(size) == Unlimited {
+		return 0, &ParseError{Input: input, Offset: 0, Kind: ErrOverflow}
+	}
+
+	return Limit(size), nil
+}
+
+// isUnlimited reports whether the limit is one of the spellings of Unlimited.
+func isUnlimited(limit string) bool {
+	limit = strings.TrimSpace(limit)
+	for _, spelling := range dialects {
+		if strings.EqualFold(limit, spelling) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,305 @@
+package size
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   string
+		want    Limit
+		wantErr error
+	}{
+		{
+			name:  "max",
+			limit: "max",
+			want:  Unlimited,
+		},
+		{
+			name:  "infinity",
+			limit: "infinity",
+			want:  Unlimited,
+		},
+		{
+			name:  "unlimited",
+			limit: "Unlimited",
+			want:  Unlimited,
+		},
+		{
+			name:  "minusOne",
+			limit: " -1 ",
+			want:  Unlimited,
+		},
+		{
+			name:  "size",
+			limit: "512MiB",
+			want:  Limit(512 * MiB),
+		},
+		{
+			name:  "bytes",
+			limit: "4096",
+			want:  4096,
+		},
+		{
+			name:    "negative",
+			limit:   "-2",
+			wantErr: ErrNegative,
+		},
+		{
+			name:    "unknownUnit",
+			limit:   "maximum",
+			wantErr: ErrInvalidNumber,
+		},
+		{
+			name:  "largestFinite",
+			limit: "18446744073709551614",
+			want:  Unlimited - 1,
+		},
+		{
+			name:    "reserved",
+			limit:   "18446744073709551615",
+			wantErr: ErrOverflow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLimit(tt.limit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseLimit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseLimit() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimit_Allows(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit Limit
+		size  Size
+		want  bool
+	}{
+		{
+			name:  "below",
+			limit: Limit(GiB),
+			size:  MiB,
+			want:  true,
+		},
+		{
+			name:  "equal",
+			limit: Limit(GiB),
+			size:  GiB,
+			want:  true,
+		},
+		{
+			name:  "above",
+			limit: Limit(GiB),
+			size:  GiB + 1,
+			want:  false,
+		},
+		{
+			name:  "unlimited",
+			limit: Unlimited,
+			size:  8 * EiB,
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.limit.Allows(tt.size); got != tt.want {
+				t.Errorf("Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimit_Format(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   Limit
+		dialect Dialect
+		want    string
+	}{
+		{
+			name:    "finite",
+			limit:   Limit(512 * MiB),
+			dialect: DialectInfinity,
+			want:    "512MiB",
+		},
+		{
+			name:    "max",
+			limit:   Unlimited,
+			dialect: DialectMax,
+			want:    "max",
+		},
+		{
+			name:    "infinity",
+			limit:   Unlimited,
+			dialect: DialectInfinity,
+			want:    "infinity",
+		},
+		{
+			name:    "unlimited",
+			limit:   Unlimited,
+			dialect: DialectUnlimited,
+			want:    "unlimited",
+		},
+		{
+			name:    "minusOne",
+			limit:   Unlimited,
+			dialect: DialectMinusOne,
+			want:    "-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.limit.Format(tt.dialect); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimit_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   Limit
+		dialect Dialect
+		want    string
+	}{
+		{
+			name:    "finite",
+			limit:   Limit(2 * GiB),
+			dialect: DialectMax,
+			want:    `{"memory":"2GiB"}`,
+		},
+		{
+			name:    "max",
+			limit:   Unlimited,
+			dialect: DialectMax,
+			want:    `{"memory":"max"}`,
+		},
+		{
+			name:    "infinity",
+			limit:   Unlimited,
+			dialect: DialectInfinity,
+			want:    `{"memory":"infinity"}`,
+		},
+		{
+			name:    "minusOne",
+			limit:   Unlimited,
+			dialect: DialectMinusOne,
+			want:    `{"memory":-1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(dialect Dialect) { LimitDialect = dialect }(LimitDialect)
+			LimitDialect = tt.dialect
+
+			data, err := json.Marshal(struct {
+				Memory Limit `json:"memory"`
+			}{Memory: tt.limit})
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+
+			var decoded struct {
+				Memory Limit `json:"memory"`
+			}
+			if err = json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if decoded.Memory != tt.limit {
+				t.Errorf("json.Unmarshal() = %d, want %d", decoded.Memory, tt.limit)
+			}
+		})
+	}
+}
+
+func TestLimit_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Limit
+		wantErr bool
+	}{
+		{
+			name: "infinity",
+			text: "infinity",
+			want: Unlimited,
+		},
+		{
+			name: "size",
+			text: "1.5GiB",
+			want: Limit(1536 * MiB),
+		},
+		{
+			name: "bytes",
+			text: "1024",
+			want: 1024,
+		},
+		{
+			name:    "invalid",
+			text:    "none",
+			wantErr: true,
+		},
+		{
+			name:    "reserved",
+			text:    "18446744073709551615",
+			wantErr: true,
+		},
+		{
+			name:    "reserved/unit",
+			text:    "18446744073709551615B",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Limit
+			if err := got.UnmarshalText([]byte(tt.text)); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalText() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimit_UnmarshalJSON_Reserved(t *testing.T) {
+	for _, data := range []string{`18446744073709551615`, `"18446744073709551615B"`} {
+		var got Limit
+		if err := json.Unmarshal([]byte(data), &got); !errors.Is(err, ErrOverflow) {
+			t.Errorf("json.Unmarshal(%s) error = %v, want %v", data, err, ErrOverflow)
+		}
+	}
+}
+
+// Examples
+
+func ExampleParseLimit() {
+	limit, _ := ParseLimit("max")
+
+	fmt.Println(limit.IsUnlimited())
+	fmt.Println(limit.Allows(8 * EiB))
+	fmt.Println(limit.Format(DialectInfinity))
+	// Output:
+	// true
+	// true
+	// infinity
+}
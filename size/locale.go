@@ -0,0 +1,302 @@
+package size
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Locale defines how sizes are written in a language, a missing symbol or long name
+// falls back to the Suffix or its English long name.
+type Locale struct {
+	// Decimal is the decimal separator (eg. "," in "1,5 ГБ").
+	Decimal string
+	// Group separates the integer digits in groups of three (eg. "." in "1.024 MiB"), not grouped if empty.
+	Group string
+	// Symbols are the localized unit symbols (eg. "ГБ" for GB).
+	Symbols map[Suffix]string
+	// Names are the localized long names of the units, one per plural form.
+	Names map[Suffix][]string
+	// Plural returns the plural form of the long names for the number, written with a '.' decimal separator,
+	// the English forms, singular when the number is 1 and plural otherwise, are used if nil.
+	Plural func(number string) int
+}
+
+var (
+	// LocaleEnglish writes sizes as "1,536.5 MiB" and "1.5 gigabytes".
+	LocaleEnglish = &Locale{Decimal: ".", Group: ","}
+
+	// LocaleRussian writes sizes as "1 536,5 МиБ" and "1,5 гигабайта".
+	LocaleRussian = &Locale{
+		Decimal: ",",
+		Group:   "\u00a0",
+		Symbols: map[Suffix]string{
+			Byte: "Б", KiloByte: "кБ", MegaByte: "МБ", GigaByte: "ГБ", TeraByte: "ТБ", PetaByte: "ПБ", ExaByte: "ЭБ",
+			KibiByte: "КиБ", MebiByte: "МиБ", GibiByte: "ГиБ", TebiByte: "ТиБ", PebiByte: "ПиБ", ExbiByte: "ЭиБ",
+		},
+		Names: russianNames(map[Suffix]string{
+			Byte: "байт", KiloByte: "килобайт", MegaByte: "мегабайт", GigaByte: "гигабайт",
+			TeraByte: "терабайт", PetaByte: "петабайт", ExaByte: "эксабайт",
+			KibiByte: "кибибайт", MebiByte: "мебибайт", GibiByte: "гибибайт",
+			TebiByte: "тебибайт", PebiByte: "пебибайт", ExbiByte: "эксбибайт",
+		}),
+		Plural: russianPlural,
+	}
+
+	// LocaleGerman writes sizes as "1.536,5 MiB" and "1,5 Gigabytes".
+	LocaleGerman = &Locale{
+		Decimal: ",",
+		Group:   ".",
+		Names: map[Suffix][]string{
+			Byte: {"Byte", "Bytes"}, KiloByte: {"Kilobyte", "Kilobytes"}, MegaByte: {"Megabyte", "Megabytes"},
+			GigaByte: {"Gigabyte", "Gigabytes"}, TeraByte: {"Terabyte", "Terabytes"},
+			PetaByte: {"Petabyte", "Petabytes"}, ExaByte: {"Exabyte", "Exabytes"},
+			KibiByte: {"Kibibyte", "Kibibytes"}, MebiByte: {"Mebibyte", "Mebibytes"},
+			GibiByte: {"Gibibyte", "Gibibytes"}, TebiByte: {"Tebibyte", "Tebibytes"},
+			PebiByte: {"Pebibyte", "Pebibytes"}, ExbiByte: {"Exbibyte", "Exbibytes"},
+		},
+	}
+
+	// LocaleFrench writes sizes as "1 536,5 Mio" and "1,5 gigaoctet".
+	LocaleFrench = &Locale{
+		Decimal: ",",
+		Group:   "\u202f",
+		Symbols: map[Suffix]string{
+			Byte: "o", KiloByte: "ko", MegaByte: "Mo", GigaByte: "Go", TeraByte: "To", PetaByte: "Po", ExaByte: "Eo",
+			KibiByte: "Kio", MebiByte: "Mio", GibiByte: "Gio", TebiByte: "Tio", PebiByte: "Pio", ExbiByte: "Eio",
+		},
+		Names: map[Suffix][]string{
+			Byte: {"octet", "octets"}, KiloByte: {"kilooctet", "kilooctets"}, MegaByte: {"mégaoctet", "mégaoctets"},
+			GigaByte: {"gigaoctet", "gigaoctets"}, TeraByte: {"téraoctet", "téraoctets"},
+			PetaByte: {"pétaoctet", "pétaoctets"}, ExaByte: {"exaoctet", "exaoctets"},
+			KibiByte: {"kibioctet", "kibioctets"}, MebiByte: {"mébioctet", "mébioctets"},
+			GibiByte: {"gibioctet", "gibioctets"}, TebiByte: {"tébioctet", "tébioctets"},
+			PebiByte: {"pébioctet", "pébioctets"}, ExbiByte: {"exbioctet", "exbioctets"},
+		},
+		Plural: frenchPlural,
+	}
+
+	// Locales is the table LookupLocale searches, keyed by the lowercase language (eg. "ru"),
+	// other locales may be added to it before use.
+	Locales = map[string]*Locale{
+		"en": LocaleEnglish,
+		"ru": LocaleRussian,
+		"de": LocaleGerman,
+		"fr": LocaleFrench,
+	}
+)
+
+// LookupLocale returns the Locale of a language tag (eg. "ru", "de-DE", "fr_CA"),
+// it falls back to the language of a tag with a region.
+func LookupLocale(tag string) (*Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	if locale, exist := Locales[tag]; exist {
+		return locale, true
+	}
+
+	if i := strings.IndexByte(tag, '-'); i >= 0 {
+		locale, exist := Locales[tag[:i]]
+		return locale, exist
+	}
+
+	return nil, false
+}
+
+// formatNumber groups the integer digits and places the decimal separator into a number
+// written with a '.' decimal separator.
+func (l *Locale) formatNumber(number string) string {
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	if l.Group != "" && len(integer) > 3 {
+		var builder strings.Builder
+		for i := 0; i < len(integer); i++ {
+			if i > 0 && (len(integer)-i)%3 == 0 {
+				builder.WriteString(l.Group)
+			}
+
+			builder.WriteByte(integer[i])
+		}
+
+		integer = builder.String()
+	}
+
+	if !hasFraction {
+		return integer
+	}
+
+	decimal := l.Decimal
+	if decimal == "" {
+		decimal = "."
+	}
+
+	return integer + decimal + fraction
+}
+
+// unit returns the localized symbol or, if long, the long name of the suffix in the plural form of the number.
+func (l *Locale) unit(suffix Suffix, number string, long bool) string {
+	if !long {
+		if symbol, exist := l.Symbols[suffix]; exist {
+			return symbol
+		}
+
+		return string(suffix)
+	}
+
+	if names := l.Names[suffix]; len(names) > 0 {
+		form := englishPlural(number)
+		if l.Plural != nil {
+			form = l.Plural(number)
+		}
+
+		if form < 0 || form >= len(names) {
+			form = len(names) - 1
+		}
+
+		return names[form]
+	}
+
+	if englishPlural(number) == 0 {
+		return suffix.Singular()
+	}
+
+	return suffix.Plural()
+}
+
+// localUnits maps the lowercase localized symbols and long names to their Suffix.
+func (l *Locale) localUnits() map[string]Suffix {
+	units := map[string]Suffix{}
+	for suffix, symbol := range l.Symbols {
+		units[strings.ToLower(symbol)] = suffix
+	}
+
+	for suffix, names := range l.Names {
+		for _, name := range names {
+			units[strings.ToLower(name)] = suffix
+		}
+	}
+
+	return units
+}
+
+// normalize rewrites a localized size (eg. "1 536,5 МиБ") in the locale-neutral form (eg. "1536.5 MiB"),
+// it returns false if the group separators do not separate groups of three digits. The offset of
+// the unit in the size and in the normalized size are returned to map the offsets of errors.
+func (l *Locale) normalize(size string, units map[string]Suffix) (string, int, int, bool) {
+	trimmed := strings.TrimRightFunc(size, unicode.IsSpace)
+
+	unitOffset := strings.LastIndexFunc(trimmed, func(r rune) bool { return !unicode.IsLetter(r) }) + 1
+	number, unit := trimmed[:unitOffset], trimmed[unitOffset:]
+
+	separator := ""
+	if spaced := number; len(number) > 0 {
+		if number = strings.TrimRightFunc(number, unicode.IsSpace); len(number) < len(spaced) {
+			separator = " "
+		}
+	}
+
+	number, ok := l.parseNumber(number)
+	if !ok {
+		return size, unitOffset, unitOffset, false
+	}
+
+	if suffix, exist := units[strings.ToLower(unit)]; exist {
+		unit = string(suffix)
+	}
+
+	return number + separator + unit + size[len(trimmed):], unitOffset, len(number) + len(separator), true
+}
+
+// parseNumber removes the group separators and replaces the decimal separator of a localized number with '.'.
+func (l *Locale) parseNumber(number string) (string, bool) {
+	integer, fraction, hasFraction := number, "", false
+	if l.Decimal != "" {
+		integer, fraction, hasFraction = strings.Cut(number, l.Decimal)
+	}
+
+	if l.Group != "" {
+		groups := splitGroups(integer, l.Group)
+		if leading := len(strings.TrimLeft(groups[0], "+-")); len(groups) > 1 && (leading == 0 || leading > 3) {
+			return number, false
+		}
+
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return number, false
+			}
+		}
+
+		integer = strings.Join(groups, "")
+	}
+
+	if !hasFraction {
+		return integer, true
+	}
+
+	return integer + "." + fraction, true
+}
+
+// splitGroups splits the integer at the group separator, a space separator also matches
+// the other spaces people type in its place.
+func splitGroups(integer, group string) []string {
+	if strings.TrimFunc(group, unicode.IsSpace) != "" {
+		return strings.Split(integer, group)
+	}
+
+	if groups := strings.FieldsFunc(integer, unicode.IsSpace); len(groups) > 0 {
+		return groups
+	}
+
+	return []string{""}
+}
+
+// englishPlural returns the singular for a value of exactly 1 whatever its decimals (eg. "1", "1.00")
+// and the plural otherwise.
+func englishPlural(number string) int {
+	if integer, fraction, _ := strings.Cut(number, "."); integer == "1" && strings.TrimRight(fraction, "0") == "" {
+		return 0
+	}
+
+	return 1
+}
+
+// frenchPlural returns the singular for numbers below 2 (eg. "1,5 octet") and the plural otherwise.
+func frenchPlural(number string) int {
+	if integer, _, _ := strings.Cut(number, "."); integer == "0" || integer == "1" {
+		return 0
+	}
+
+	return 1
+}
+
+// russianPlural returns the form for 1, 21, 31, ... (eg. "1 байт"), for 2-4, 22-24, ... and fractions
+// (eg. "2 байта", "1,5 байта"), or for the rest (eg. "5 байт").
+func russianPlural(number string) int {
+	if strings.Contains(number, ".") {
+		return 1
+	}
+
+	tens := 0
+	if len(number) > 1 {
+		tens = int(number[len(number)-2] - '0')
+	}
+
+	switch ones := int(number[len(number)-1] - '0'); {
+	case tens == 1:
+		return 2
+	case ones == 1:
+		return 0
+	case ones >= 2 && ones <= 4:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// russianNames returns the forms of the long names that decline as "байт", "байта", "байт".
+func russianNames(stems map[Suffix]string) map[Suffix][]string {
+	names := make(map[Suffix][]string, len(stems))
+	for suffix, stem := range stems {
+		names[suffix] = []string{stem, stem + "а", stem}
+	}
+
+	return names
+}
@@ -0,0 +1,211 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestFormatWith_Locale(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    Size
+		options FormatOptions
+		want    string
+	}{
+		{
+			name:    "ru/symbol",
+			size:    1536 * MiB,
+			options: FormatOptions{System: Binary, TrimZeros: true, Separator: " ", Locale: LocaleRussian},
+			want:    "1,5 ГиБ",
+		},
+		{
+			name:    "ru/decimal",
+			size:    512 * MB,
+			options: FormatOptions{System: Decimal, TrimZeros: true, Separator: " ", Locale: LocaleRussian},
+			want:    "512 МБ",
+		},
+		{
+			name:    "ru/group",
+			size:    2048 * MiB,
+			options: FormatOptions{MaxUnit: MiB, TrimZeros: true, Separator: " ", Locale: LocaleRussian},
+			want:    "2\u00a0048 МиБ",
+		},
+		{
+			name:    "ru/one",
+			size:    21,
+			options: FormatOptions{TrimZeros: true, LongNames: true, Locale: LocaleRussian},
+			want:    "21 байт",
+		},
+		{
+			name:    "ru/few",
+			size:    3 * GB,
+			options: FormatOptions{System: Decimal, TrimZeros: true, LongNames: true, Locale: LocaleRussian},
+			want:    "3 гигабайта",
+		},
+		{
+			name:    "ru/many",
+			size:    11 * KiB,
+			options: FormatOptions{TrimZeros: true, LongNames: true, Locale: LocaleRussian},
+			want:    "11 кибибайт",
+		},
+		{
+			name:    "ru/fraction",
+			size:    1536 * MiB,
+			options: FormatOptions{TrimZeros: true, LongNames: true, Locale: LocaleRussian},
+			want:    "1,5 гибибайта",
+		},
+		{
+			name:    "de",
+			size:    1536 * MiB,
+			options: FormatOptions{Precision: 2, Fixed: true, Separator: " ", Locale: LocaleGerman},
+			want:    "1,50 GiB",
+		},
+		{
+			name:    "de/group",
+			size:    1500 * KB,
+			options: FormatOptions{System: Decimal, MaxUnit: KB, TrimZeros: true, Separator: " ", Locale: LocaleGerman},
+			want:    "1.500 kB",
+		},
+		{
+			name:    "de/longNames",
+			size:    GiB,
+			options: FormatOptions{TrimZeros: true, LongNames: true, Locale: LocaleGerman},
+			want:    "1 Gibibyte",
+		},
+		{
+			name:    "fr",
+			size:    1536 * MiB,
+			options: FormatOptions{TrimZeros: true, Separator: " ", Locale: LocaleFrench},
+			want:    "1,5 Gio",
+		},
+		{
+			name:    "fr/singular",
+			size:    1536 * MiB,
+			options: FormatOptions{TrimZeros: true, LongNames: true, Locale: LocaleFrench},
+			want:    "1,5 gibioctet",
+		},
+		{
+			name:    "fr/plural",
+			size:    2 * MB,
+			options: FormatOptions{System: Decimal, TrimZeros: true, LongNames: true, Locale: LocaleFrench},
+			want:    "2 mégaoctets",
+		},
+		{
+			name:    "en",
+			size:    1536*KiB + 512,
+			options: FormatOptions{MaxUnit: KiB, Precision: 1, Fixed: true, Separator: " ", Locale: LocaleEnglish},
+			want:    "1,536.5 KiB",
+		},
+		{
+			name:    "en/longNames",
+			size:    1500 * MB,
+			options: FormatOptions{System: Decimal, TrimZeros: true, LongNames: true, Locale: LocaleEnglish},
+			want:    "1.5 gigabytes",
+		},
+		{
+			name:    "missingSymbol",
+			size:    2 * PB,
+			options: FormatOptions{System: Decimal, TrimZeros: true, Locale: &Locale{Decimal: ","}},
+			want:    "2PB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatWith(tt.size, tt.options); got != tt.want {
+				t.Errorf("FormatWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_Locale(t *testing.T) {
+	tests := []struct {
+		name    string
+		locale  *Locale
+		size    string
+		want    Size
+		wantErr error
+	}{
+		{name: "ru/symbol", locale: LocaleRussian, size: "1,5 ГБ", want: 1500 * MB},
+		{name: "ru/binary", locale: LocaleRussian, size: "512 МиБ", want: 512 * MiB},
+		{name: "ru/caseInsensitive", locale: LocaleRussian, size: "512 мб", want: 512 * MB},
+		{name: "ru/longName", locale: LocaleRussian, size: "2 гигабайта", want: 2 * GB},
+		{name: "ru/group", locale: LocaleRussian, size: "2\u00a0048 МиБ", want: 2048 * MiB},
+		{name: "ru/groupSpace", locale: LocaleRussian, size: "2 048 КиБ", want: 2048 * KiB},
+		{name: "ru/neutralUnit", locale: LocaleRussian, size: "1,5 GiB", want: 1536 * MiB},
+		{name: "ru/noUnit", locale: LocaleRussian, size: "1\u00a0024", want: 1024},
+		{name: "de", locale: LocaleGerman, size: "1,5 GB", want: 1500 * MB},
+		{name: "de/group", locale: LocaleGerman, size: "1.024,5 KiB", want: 1024*KiB + 512},
+		{name: "de/longName", locale: LocaleGerman, size: "2 Megabytes", want: 2 * MB},
+		{name: "de/badGroup", locale: LocaleGerman, size: "1.5 GB", wantErr: ErrInvalidNumber},
+		{name: "fr", locale: LocaleFrench, size: "1,5 Go", want: 1500 * MB},
+		{name: "fr/group", locale: LocaleFrench, size: "1\u202f536 Mio", want: 1536 * MiB},
+		{name: "fr/longName", locale: LocaleFrench, size: "3 kibioctets", want: 3 * KiB},
+		{name: "en", locale: LocaleEnglish, size: "1,536.5 KiB", want: 1536*KiB + 512},
+		{name: "en/longName", locale: LocaleEnglish, size: "2 megabytes", want: 2 * MB},
+		{name: "unknownUnit", locale: LocaleRussian, size: "1 ГЫ", wantErr: ErrUnknownUnit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(WithLocale(tt.locale)).Parse(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_LocaleError(t *testing.T) {
+	_, err := NewParser(WithLocale(LocaleRussian)).Parse("1\u00a0024 ГЫ")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Parse() error = %v, want *ParseError", err)
+	}
+
+	if parseErr.Input != "1\u00a0024 ГЫ" || parseErr.Offset != len("1\u00a0024 ") || parseErr.Unit != "ГЫ" {
+		t.Errorf("Parse() error = %+v", parseErr)
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		name      string
+		tag       string
+		want      *Locale
+		wantExist bool
+	}{
+		{name: "language", tag: "ru", want: LocaleRussian, wantExist: true},
+		{name: "region", tag: "de-AT", want: LocaleGerman, wantExist: true},
+		{name: "underscore", tag: "fr_CA", want: LocaleFrench, wantExist: true},
+		{name: "upperCase", tag: "EN-US", want: LocaleEnglish, wantExist: true},
+		{name: "unknown", tag: "ja-JP", want: nil, wantExist: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, exist := LookupLocale(tt.tag)
+			if got != tt.want || exist != tt.wantExist {
+				t.Errorf("LookupLocale() = %v, %v, want %v, %v", got, exist, tt.want, tt.wantExist)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleWithLocale() {
+	locale, _ := LookupLocale("ru-RU")
+
+	size, _ := NewParser(WithLocale(locale)).Parse("1,5 ГиБ")
+	fmt.Println(FormatWith(size, FormatOptions{TrimZeros: true, Separator: " ", Locale: locale}))
+	fmt.Println(FormatWith(size, FormatOptions{TrimZeros: true, LongNames: true, Locale: locale}))
+	// Output:
+	// 1,5 ГиБ
+	// 1,5 гибибайта
+}
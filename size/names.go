@@ -0,0 +1,102 @@
+package size
+
+import "strings"
+
+// longName is the spelled out name of a unit.
+type longName struct {
+	singular string
+	plural   string
+}
+
+var (
+	longNames = map[Suffix]longName{
+		Byte: {singular: "byte", plural: "bytes"},
+
+		KiloByte:      {singular: "kilobyte", plural: "kilobytes"},
+		JEDECKiloByte: {singular: "kilobyte", plural: "kilobytes"},
+		MegaByte:      {singular: "megabyte", plural: "megabytes"},
+		GigaByte:      {singular: "gigabyte", plural: "gigabytes"},
+		TeraByte:      {singular: "terabyte", plural: "terabytes"},
+		PetaByte:      {singular: "petabyte", plural: "petabytes"},
+		ExaByte:       {singular: "exabyte", plural: "exabytes"},
+		ZettaByte:     {singular: "zettabyte", plural: "zettabytes"},
+		YottaByte:     {singular: "yottabyte", plural: "yottabytes"},
+		RonnaByte:     {singular: "ronnabyte", plural: "ronnabytes"},
+		QuettaByte:    {singular: "quettabyte", plural: "quettabytes"},
+
+		KibiByte: {singular: "kibibyte", plural: "kibibytes"},
+		MebiByte: {singular: "mebibyte", plural: "mebibytes"},
+		GibiByte: {singular: "gibibyte", plural: "gibibytes"},
+		TebiByte: {singular: "tebibyte", plural: "tebibytes"},
+		PebiByte: {singular: "pebibyte", plural: "pebibytes"},
+		ExbiByte: {singular: "exbibyte", plural: "exbibytes"},
+		ZebiByte: {singular: "zebibyte", plural: "zebibytes"},
+		YobiByte: {singular: "yobibyte", plural: "yobibytes"},
+	}
+
+	// longNameSuffixes maps the singular and plural long names to the Suffix,
+	// the SI kilobyte is preferred over the JEDEC one.
+	longNameSuffixes = newLongNameSuffixes(longNames, KiloByte)
+)
+
+// Singular returns the spelled out singular name of the suffix (eg. "gigabyte"),
+// or the suffix itself if it has no long name.
+func (s Suffix) Singular() string {
+	if name, exist := longNames[s]; exist {
+		return name.singular
+	}
+
+	return string(s)
+}
+
+// Plural returns the spelled out plural name of the suffix (eg. "gigabytes"),
+// or the suffix itself if it has no long name.
+func (s Suffix) Plural() string {
+	if name, exist := longNames[s]; exist {
+		return name.plural
+	}
+
+	return string(s)
+}
+
+// lookupLongName finds the unit of a long name (eg. "megabytes", "Gibibyte") case-insensitively,
+// the unit is looked up by its suffix in the units, which are keyed as in Parser.
+func lookupLongName[T string | []byte, V any](name T, units map[string]V, caseSensitive bool) (V, bool) {
+	suffix, exist := lookupUnit(name, longNameSuffixes, false)
+	if !exist {
+		var unit V
+		return unit, false
+	}
+
+	unit, exist := units[unitKey(suffix, caseSensitive)]
+	if !exist && suffix == KiloByte {
+		// "kilobyte" also names the JEDEC kilobyte for parsers that only allow JEDEC units.
+		unit, exist = units[unitKey(JEDECKiloByte, caseSensitive)]
+	}
+
+	return unit, exist
+}
+
+// unitKey returns the key of the suffix in the units of a parser.
+func unitKey(suffix Suffix, caseSensitive bool) string {
+	if caseSensitive {
+		return string(suffix)
+	}
+
+	return strings.ToLower(string(suffix))
+}
+
+func newLongNameSuffixes(names map[Suffix]longName, preferred ...Suffix) map[string]Suffix {
+	suffixes := map[string]Suffix{}
+	for suffix, name := range names {
+		suffixes[name.singular] = suffix
+		suffixes[name.plural] = suffix
+	}
+
+	for _, suffix := range preferred {
+		suffixes[names[suffix].singular] = suffix
+		suffixes[names[suffix].plural] = suffix
+	}
+
+	return suffixes
+}
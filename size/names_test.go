@@ -0,0 +1,81 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestSuffix_LongName(t *testing.T) {
+	tests := []struct {
+		name         string
+		suffix       Suffix
+		wantSingular string
+		wantPlural   string
+	}{
+		{name: "byte", suffix: Byte, wantSingular: "byte", wantPlural: "bytes"},
+		{name: "decimal", suffix: GigaByte, wantSingular: "gigabyte", wantPlural: "gigabytes"},
+		{name: "binary", suffix: KibiByte, wantSingular: "kibibyte", wantPlural: "kibibytes"},
+		{name: "jedec", suffix: JEDECKiloByte, wantSingular: "kilobyte", wantPlural: "kilobytes"},
+		{name: "unknown", suffix: "Kb", wantSingular: "Kb", wantPlural: "Kb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.suffix.Singular(); got != tt.wantSingular {
+				t.Errorf("Singular() = %v, want %v", got, tt.wantSingular)
+			}
+			if got := tt.suffix.Plural(); got != tt.wantPlural {
+				t.Errorf("Plural() = %v, want %v", got, tt.wantPlural)
+			}
+		})
+	}
+}
+
+func TestParse_LongNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(string) (Size, error)
+		size    string
+		want    Size
+		wantErr error
+	}{
+		{name: "plural", parse: Parse, size: "2 megabytes", want: 2 * MB},
+		{name: "singular", parse: Parse, size: "1 gibibyte", want: GiB},
+		{name: "byte", parse: Parse, size: "512 bytes", want: 512},
+		{name: "fraction", parse: Parse, size: "1.5 gigabytes", want: 1500 * MB},
+		{name: "noSpace", parse: Parse, size: "512kibibytes", want: 512 * KiB},
+		{name: "caseInsensitive", parse: Parse, size: "1 Megabyte", want: MB},
+		{name: "strict", parse: NewParser(WithStrict()).Parse, size: "1 kilobyte", want: KB},
+		{name: "caseSensitive", parse: NewParser(WithCaseSensitive()).Parse, size: "3 mebibytes", want: 3 * MiB},
+		{name: "jedec", parse: ParseJEDEC, size: "1 megabyte", want: MiB},
+		{name: "jedec/kilobyte", parse: ParseJEDEC, size: "2 kilobytes", want: 2 * KiB},
+		{name: "jedec/caseSensitive", parse: NewParser(WithAllowedSystems(JEDEC), WithCaseSensitive()).Parse, size: "1 kilobyte", want: KiB},
+		{name: "human", parse: ParseHuman, size: "2 kilobytes", want: 2 * KB},
+		{name: "binary", parse: ParseBinary, size: "2 kibibytes", want: 2 * KiB},
+		{name: "binary/decimalName", parse: ParseBinary, size: "2 kilobytes", wantErr: ErrUnknownUnit},
+		{name: "systemNotAllowed", parse: NewParser(WithAllowedSystems(Binary)).Parse, size: "1 megabyte", wantErr: ErrUnknownUnit},
+		{name: "unknown", parse: Parse, size: "1 megabites", wantErr: ErrUnknownUnit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleSuffix_Plural() {
+	suffix := Suffix(GibiByte)
+	fmt.Println(suffix.Singular(), suffix.Plural())
+	// Output:
+	// gibibyte gibibytes
+}
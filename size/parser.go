@@ -0,0 +1,299 @@
+package size
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+const (
+	// Binary is the IEC system of units based on powers of 1024 (eg. "KiB", "MiB").
+	Binary System = 1 << iota
+	// Decimal is the SI system of units based on powers of 1000 (eg. "kB", "MB").
+	Decimal
+	// JEDEC is the system of memory vendors, where the SI units are powers of 1024 (eg. "KB", "MB"),
+	// it takes precedence over Decimal for the units spelled alike.
+	JEDEC
+)
+
+const (
+	// WhitespaceSingle trims whitespace around the size and allows a single space between the number and the unit.
+	WhitespaceSingle Whitespace = iota
+	// WhitespaceNone rejects whitespace anywhere in the size.
+	WhitespaceNone
+	// WhitespaceAny trims whitespace around the size and allows any whitespace between the number and the unit.
+	WhitespaceAny
+)
+
+// System is a set of systems of units, the byte unit belongs to every system.
+type System uint8
+
+// Whitespace defines the policy applied to whitespace in a size.
+type Whitespace uint8
+
+// Option configures a Parser.
+type Option func(*Parser)
+
+// Parser parses sizes according to its options, it is immutable once created
+// and safe for concurrent use.
+type Parser struct {
+	units     Units
+	available []string
+	systems   System
+
+	strict        bool
+	lenient       bool
+	caseSensitive bool
+	defaultUnit   Size
+	whitespace    Whitespace
+	max           Size
+
+	rounding Rounding
+
+	locale      *Locale
+	localeUnits map[string]Suffix
+}
+
+var defaultParser = NewParser()
+
+// NewParser returns a Parser configured by the options, by default it accepts binary and decimal units
+// case-insensitively with an optional 'b' suffix, reads numbers without a unit as bytes, rejects
+// thousands separators and resolves fractional bytes with DefaultRounding.
+func NewParser(options ...Option) *Parser {
+	parser := &Parser{systems: Binary | Decimal, defaultUnit: ByteBase, max: math.MaxUint64, rounding: DefaultRounding}
+	for _, option := range options {
+		option(parser)
+	}
+
+	var suffixes []Suffixes
+	if parser.systems&Binary != 0 {
+		suffixes = append(suffixes, binarySuffixes)
+	}
+
+	if parser.systems&Decimal != 0 {
+		suffixes = append(suffixes, decimalSuffixes)
+	}
+
+	if parser.systems&JEDEC != 0 {
+		suffixes = append(suffixes, jedecSuffixes)
+	}
+
+	if len(suffixes) == 0 {
+		suffixes = append(suffixes, binarySuffixes[:1])
+	}
+
+	parser.units, parser.available = newUnits(parser.caseSensitive, suffixes...)
+
+	if parser.locale != nil {
+		parser.localeUnits = parser.locale.localUnits()
+	}
+
+	return parser
+}
+
+// WithStrict requires the 'B' suffix of units (eg. "512KiB" rather than "512Ki")
+// and rejects thousands separators regardless of WithLenient.
+func WithStrict() Option {
+	return func(parser *Parser) {
+		parser.strict = true
+	}
+}
+
+// WithLenient enables the lenient number syntax, which also accepts commas
+// separating groups of three digits of the integer part (eg. "1,048,576B").
+func WithLenient() Option {
+	return func(parser *Parser) {
+		parser.lenient = true
+	}
+}
+
+// WithCaseSensitive requires units to be spelled as their canonical Suffix (eg. "kB", "MiB").
+func WithCaseSensitive() Option {
+	return func(parser *Parser) {
+		parser.caseSensitive = true
+	}
+}
+
+// WithDefaultUnit sets the unit of numbers without a unit (eg. "512" is 512 MiB with WithDefaultUnit(MiB)),
+// a zero unit rejects such numbers with ErrMissingUnit.
+func WithDefaultUnit(unit Size) Option {
+	return func(parser *Parser) {
+		parser.defaultUnit = unit
+	}
+}
+
+// WithAllowedSystems restricts the units to the given systems (eg. Binary or Binary|Decimal).
+func WithAllowedSystems(systems System) Option {
+	return func(parser *Parser) {
+		parser.systems = systems
+	}
+}
+
+// WithWhitespace sets the policy applied to whitespace in a size.
+func WithWhitespace(policy Whitespace) Option {
+	return func(parser *Parser) {
+		parser.whitespace = policy
+	}
+}
+
+// WithMax rejects sizes greater than the limit with ErrTooLarge.
+func WithMax(limit Size) Option {
+	return func(parser *Parser) {
+		parser.max = limit
+	}
+}
+
+// WithRounding sets the policy for values that resolve to a fractional number of bytes,
+// overriding DefaultRounding.
+func WithRounding(mode Rounding) Option {
+	return func(parser *Parser) {
+		parser.rounding = mode
+	}
+}
+
+// WithLocale accepts sizes written in the locale (eg. "1,5 ГБ", "1.024 MiB" in German), the localized
+// symbols and long names are case-insensitive, and the locale-neutral units are still accepted.
+func WithLocale(locale *Locale) Option {
+	return func(parser *Parser) {
+		parser.locale = locale
+	}
+}
+
+// Parse returns the Size or returns an error if it fails.
+func (p *Parser) Parse(size string) (Size, error) {
+	if p.locale != nil {
+		return p.parseLocalized(size, p.defaultUnit)
+	}
+
+	return parseWith(p, size, p.defaultUnit)
+}
+
+// ParseBytes is like Parse but takes the size as a byte slice,
+// it does not allocate unless the size is invalid or the Parser has a Locale.
+func (p *Parser) ParseBytes(size []byte) (Size, error) {
+	if p.locale != nil {
+		return p.parseLocalized(string(size), p.defaultUnit)
+	}
+
+	return parseWith(p, size, p.defaultUnit)
+}
+
+// ParseDefault is like Parse but reads numbers without a unit in the given unit
+// instead of the default unit of the Parser.
+func (p *Parser) ParseDefault(size string, unit Size) (Size, error) {
+	if p.locale != nil {
+		return p.parseLocalized(size, unit)
+	}
+
+	return parseWith(p, size, unit)
+}
+
+// parseLocalized parses the size once rewritten in the locale-neutral form,
+// errors report the size as given.
+func (p *Parser) parseLocalized(size string, defaultUnit Size) (Size, error) {
+	normalized, unitOffset, normalizedOffset, ok := p.locale.normalize(size, p.localeUnits)
+	if !ok {
+		return 0, &ParseError{Input: size, Offset: 0, Kind: ErrInvalidNumber}
+	}
+
+	value, err := parseWith(p, normalized, defaultUnit)
+
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		parseErr.Input = size
+		if parseErr.Offset >= normalizedOffset {
+			parseErr.Offset += unitOffset - normalizedOffset
+		} else if parseErr.Offset > unitOffset {
+			parseErr.Offset = unitOffset
+		}
+	}
+
+	return value, err
+}
+
+func parseWith[T string | []byte](p *Parser, input T, defaultUnit Size) (Size, error) {
+	lenient := p.lenient && !p.strict
+
+	tok, err := scanSizeWith(input, p.whitespace, lenient)
+	if err != nil {
+		return 0, err
+	}
+
+	name := input[tok.unitOffset:tok.end]
+
+	var unit Size
+	switch {
+	case len(name) == 0 && defaultUnit != 0:
+		unit = defaultUnit
+	case len(name) == 0:
+		return 0, &ParseError{Input: string(input), Offset: tok.unitOffset, Kind: ErrMissingUnit}
+	default:
+		var exist bool
+		if p.caseSensitive {
+			unit, exist = lookupCanonical(name, p.units, !p.strict)
+		} else {
+			unit, exist = lookupUnit(name, p.units, !p.strict)
+		}
+
+		if !exist {
+			unit, exist = lookupLongName(name, p.units, p.caseSensitive)
+		}
+
+		if !exist {
+			return 0, unknownUnitError(string(input), tok.unitOffset, string(name), p.available)
+		}
+	}
+
+	value, err := tok.number.scale(unit, p.rounding)
+	if err != nil {
+		return 0, &ParseError{Input: string(input), Offset: tok.numberOffset, Kind: err}
+	}
+
+	if value > p.max {
+		return 0, &ParseError{Input: string(input), Offset: tok.numberOffset, Kind: ErrTooLarge}
+	}
+
+	return value, nil
+}
+
+// lookupCanonical finds the unit spelled exactly as its canonical Suffix without allocating,
+// the 'B' suffix is appended to the name when it is optional and omitted.
+func lookupCanonical[T string | []byte](name T, units Units, optionalByte bool) (Size, bool) {
+	var buffer [maxUnitLength]byte
+
+	if len(name) >= len(buffer) {
+		return 0, false
+	}
+
+	n := copy(buffer[:], name)
+	if optionalByte && (n == 0 || buffer[n-1] != 'B') {
+		buffer[n] = 'B'
+		n++
+	}
+
+	unit, exist := units[string(buffer[:n])]
+	return unit, exist
+}
+
+// newUnits builds the Units of the suffixes, keyed by the lowercase or, if caseSensitive, the canonical suffix,
+// with their canonical names ordered by size and then by name, a later suffix replaces an earlier one of the same key.
+func newUnits(caseSensitive bool, suffixes ...Suffixes) (Units, []string) {
+	units, canonical := Units{}, map[string]string{}
+	for _, list := range suffixes {
+		for _, suffix := range list {
+			name := string(suffix.Suffix)
+			if !caseSensitive {
+				name = strings.ToLower(name)
+			}
+
+			units[name], canonical[name] = suffix.Unit, string(suffix.Suffix)
+		}
+	}
+
+	names := sortedNames(units)
+	for i, name := range names {
+		names[i] = canonical[name]
+	}
+
+	return units, names
+}
@@ -0,0 +1,307 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// Tests
+
+func TestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		size    string
+		want    Size
+		wantErr error
+	}{
+		{
+			name: "default",
+			size: "512 kb",
+			want: 512 * KB,
+		},
+		{
+			name: "default/bareNumber",
+			size: "512",
+			want: 512,
+		},
+		{
+			name:    "strict",
+			options: []Option{WithStrict()},
+			size:    "512KiB",
+			want:    512 * KiB,
+		},
+		{
+			name:    "strict/optionalByte",
+			options: []Option{WithStrict()},
+			size:    "512Ki",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "caseSensitive",
+			options: []Option{WithCaseSensitive()},
+			size:    "2kB",
+			want:    2 * KB,
+		},
+		{
+			name:    "caseSensitive/optionalByte",
+			options: []Option{WithCaseSensitive()},
+			size:    "2Mi",
+			want:    2 * MiB,
+		},
+		{
+			name:    "caseSensitive/wrongCase",
+			options: []Option{WithCaseSensitive()},
+			size:    "2mb",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "caseSensitive/bits",
+			options: []Option{WithCaseSensitive()},
+			size:    "2Mb",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "defaultUnit",
+			options: []Option{WithDefaultUnit(MiB)},
+			size:    "64",
+			want:    64 * MiB,
+		},
+		{
+			name:    "defaultUnit/explicit",
+			options: []Option{WithDefaultUnit(MiB)},
+			size:    "64KiB",
+			want:    64 * KiB,
+		},
+		{
+			name:    "defaultUnit/zero",
+			options: []Option{WithDefaultUnit(0)},
+			size:    "64",
+			wantErr: ErrMissingUnit,
+		},
+		{
+			name:    "binary",
+			options: []Option{WithAllowedSystems(Binary)},
+			size:    "1GiB",
+			want:    GiB,
+		},
+		{
+			name:    "binary/decimalUnit",
+			options: []Option{WithAllowedSystems(Binary)},
+			size:    "1GB",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "decimal/bytes",
+			options: []Option{WithAllowedSystems(Decimal)},
+			size:    "100B",
+			want:    100,
+		},
+		{
+			name:    "none/bytes",
+			options: []Option{WithAllowedSystems(0)},
+			size:    "100B",
+			want:    100,
+		},
+		{
+			name:    "whitespaceNone",
+			options: []Option{WithWhitespace(WhitespaceNone)},
+			size:    "1MB",
+			want:    MB,
+		},
+		{
+			name:    "whitespaceNone/separated",
+			options: []Option{WithWhitespace(WhitespaceNone)},
+			size:    "1 MB",
+			wantErr: ErrInvalidNumber,
+		},
+		{
+			name:    "whitespaceNone/surrounded",
+			options: []Option{WithWhitespace(WhitespaceNone)},
+			size:    " 1MB",
+			wantErr: ErrInvalidNumber,
+		},
+		{
+			name:    "whitespaceAny",
+			options: []Option{WithWhitespace(WhitespaceAny)},
+			size:    " 1 \t MB\n",
+			want:    MB,
+		},
+		{
+			name:    "whitespaceSingle",
+			options: []Option{WithWhitespace(WhitespaceSingle)},
+			size:    "1 \t MB",
+			wantErr: ErrUnknownUnit,
+		},
+		{
+			name:    "max",
+			options: []Option{WithMax(GiB)},
+			size:    "1GiB",
+			want:    GiB,
+		},
+		{
+			name:    "max/exceeded",
+			options: []Option{WithMax(GiB)},
+			size:    "1.5GiB",
+			wantErr: ErrTooLarge,
+		},
+		{
+			name:    "rounding",
+			options: []Option{WithRounding(RoundCeil)},
+			size:    "0.1KiB",
+			want:    103,
+		},
+		{
+			name:    "rounding/reject",
+			options: []Option{WithRounding(RoundReject)},
+			size:    "0.1KiB",
+			wantErr: ErrFractional,
+		},
+		{
+			name:    "combined",
+			options: []Option{WithStrict(), WithCaseSensitive(), WithAllowedSystems(Binary), WithDefaultUnit(KiB)},
+			size:    "4",
+			want:    4 * KiB,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(tt.options...).Parse(tt.size)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parse() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_ParseDefault(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		size    string
+		unit    Size
+		want    Size
+		wantErr error
+	}{
+		{
+			name: "bareNumber",
+			size: "64",
+			unit: KiB,
+			want: 64 * KiB,
+		},
+		{
+			name: "fractional",
+			size: "1.5",
+			unit: MiB,
+			want: 1536 * KiB,
+		},
+		{
+			name: "explicitUnit",
+			size: "64MB",
+			unit: KiB,
+			want: 64 * MB,
+		},
+		{
+			name:    "overridesParser",
+			options: []Option{WithDefaultUnit(MiB)},
+			size:    "64",
+			unit:    GiB,
+			want:    64 * GiB,
+		},
+		{
+			name:    "zero",
+			size:    "64",
+			wantErr: ErrMissingUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewParser(tt.options...).ParseDefault(tt.size, tt.unit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseDefault() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDefault() got = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_Strict(t *testing.T) {
+	if got, err := NewParser(WithLenient()).Parse("1,024KiB"); err != nil || got != MiB {
+		t.Errorf("Parse() got = %d, %v, want %d", got, err, MiB)
+	}
+
+	if _, err := NewParser(WithStrict(), WithLenient()).Parse("1,024KiB"); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("Parse() error = %v, wantErr %v", err, ErrInvalidNumber)
+	}
+}
+
+func TestParser_Available(t *testing.T) {
+	_, err := NewParser(WithAllowedSystems(Binary)).Parse("1XiB")
+
+	want := "size: unit 'XiB' unknown, available units [B, KiB, MiB, GiB, TiB, PiB, EiB]"
+	if err == nil || err.Error() != want {
+		t.Errorf("Parse() error = %v, want %s", err, want)
+	}
+}
+
+func TestParser_Concurrent(t *testing.T) {
+	parser := NewParser(WithDefaultUnit(MiB), WithMax(TiB))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				if got, err := parser.Parse("512"); err != nil || got != 512*MiB {
+					t.Errorf("Parse() got = %d, %v, want %d", got, err, 512*MiB)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// Examples
+
+func ExampleNewParser() {
+	parser := NewParser(WithAllowedSystems(Binary), WithDefaultUnit(MiB), WithMax(GiB))
+
+	fmt.Println(parser.Parse("256"))
+	fmt.Println(parser.Parse("2GiB"))
+	// Output:
+	// 256MiB <nil>
+	// 0B size: value exceeds limit in '2GiB' at offset 0
+}
+
+func ExampleParseDefault() {
+	fmt.Println(ParseDefault("64", MiB))
+	fmt.Println(ParseDefault("64KiB", MiB))
+	// Output:
+	// 64MiB <nil>
+	// 64KiB <nil>
+}
+
+// Benchmark
+
+func BenchmarkParser_Parse(b *testing.B) {
+	parser := NewParser(WithStrict(), WithCaseSensitive(), WithDefaultUnit(MiB))
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = parser.Parse("32.5 MiB")
+	}
+}
@@ -0,0 +1,144 @@
+package size
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Relative is a size given either absolutely (eg. "512MiB") or as a fraction of a total
+// (eg. "75%", "0.25x") that is resolved once the total is known.
+type Relative struct {
+	size     Size
+	fraction decimal
+	relative bool
+}
+
+// Absolute returns the Relative that resolves to the size whatever the total.
+func Absolute(size Size) Relative {
+	return Relative{size: size}
+}
+
+// ParseRelative parses a percentage (eg. "75%"), a fraction (eg. "0.25x") or,
+// otherwise, an absolute size with Parse, fractions are kept exact until resolved.
+func ParseRelative(relative string) (Relative, error) {
+	trimmed := strings.TrimSpace(relative)
+
+	number, exp := "", 0
+	switch {
+	case strings.HasSuffix(trimmed, "%"):
+		number, exp = strings.TrimSuffix(trimmed, "%"), -2
+	case strings.HasSuffix(trimmed, "x"), strings.HasSuffix(trimmed, "X"):
+		number = trimmed[:len(trimmed)-1]
+	default:
+		value, err := Parse(relative)
+		if err != nil {
+			return Relative{}, err
+		}
+
+		return Absolute(value), nil
+	}
+
+	number = strings.TrimSuffix(number, " ")
+	offset := strings.Index(relative, number)
+
+	if strings.HasPrefix(number, "-") {
+		return Relative{}, &ParseError{Input: relative, Offset: offset, Kind: ErrNegative}
+	}
+
+	fraction, ok := parseDecimal(number)
+	if !ok {
+		return Relative{}, &ParseError{Input: relative, Offset: offset, Kind: ErrInvalidNumber}
+	}
+
+	fraction.exp += exp
+
+	return Relative{fraction: fraction, relative: true}, nil
+}
+
+// IsRelative reports whether the size is a fraction of a total.
+func (r Relative) IsRelative() bool {
+	return r.relative
+}
+
+// Resolve returns the size against the total, fractional bytes are resolved with the given Rounding.
+func (r Relative) Resolve(total Size, rounding Rounding) (Size, error) {
+	if !r.relative {
+		return r.size, nil
+	}
+
+	return r.fraction.scale(total, rounding)
+}
+
+// String returns the size as a percentage (eg. "75%", "12.5%") or as an absolute Size.
+func (r Relative) String() string {
+	if !r.relative {
+		return r.size.String()
+	}
+
+	return r.percent()
+}
+
+// MarshalText implements encoding.TextMarshaler, an absolute size is encoded as a Size.
+func (r Relative) MarshalText() ([]byte, error) {
+	if !r.relative {
+		return r.size.MarshalText()
+	}
+
+	return []byte(r.percent()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts what ParseRelative does
+// and a bare integer, which is treated as a number of bytes.
+func (r *Relative) UnmarshalText(text []byte) error {
+	var size Size
+	if err := size.UnmarshalText(text); err == nil {
+		*r = Absolute(size)
+		return nil
+	}
+
+	value, err := ParseRelative(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = value
+	return nil
+}
+
+// Set implements flag.Value, the value is parsed with ParseRelative.
+func (r *Relative) Set(value string) error {
+	return r.UnmarshalText([]byte(value))
+}
+
+// Type returns the name of the value type, it is required by spf13/pflag.Value.
+func (r *Relative) Type() string {
+	return "relative"
+}
+
+// percent returns the fraction as an exact percentage.
+func (r Relative) percent() string {
+	digits := strconv.FormatUint(r.fraction.mantissa, 10)
+	if r.fraction.overflow {
+		digits = mantissaDigits(r.fraction.text)
+	}
+
+	exp := r.fraction.exp + 2
+	if exp > 0 {
+		digits, exp = digits+strings.Repeat("0", exp), 0
+	}
+
+	if len(digits) <= -exp {
+		digits = strings.Repeat("0", -exp-len(digits)+1) + digits
+	}
+
+	integer, fraction := digits[:len(digits)+exp], strings.TrimRight(digits[len(digits)+exp:], "0")
+	if integer = strings.TrimLeft(integer, "0"); integer == "" {
+		integer = "0"
+	}
+
+	if fraction == "" {
+		return integer + "%"
+	}
+
+	return integer + "." + fraction + "%"
+}
@@ -0,0 +1,196 @@
+package size
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestParseRelative(t *testing.T) {
+	tests := []struct {
+		name     string
+		relative string
+		total    Size
+		rounding Rounding
+		want     Size
+		wantErr  error
+	}{
+		{
+			name:     "percent",
+			relative: "75%",
+			total:    8 * GiB,
+			want:     6 * GiB,
+		},
+		{
+			name:     "percent/space",
+			relative: " 50 % ",
+			total:    GiB,
+			want:     512 * MiB,
+		},
+		{
+			name:     "percent/fractional",
+			relative: "12.5%",
+			total:    KiB,
+			want:     128,
+		},
+		{
+			name:     "fraction",
+			relative: "0.25x",
+			total:    4 * GB,
+			want:     GB,
+		},
+		{
+			name:     "fraction/overcommit",
+			relative: "1.5X",
+			total:    GiB,
+			want:     1536 * MiB,
+		},
+		{
+			name:     "absolute",
+			relative: "512MiB",
+			total:    GiB,
+			want:     512 * MiB,
+		},
+		{
+			name:     "floor",
+			relative: "33%",
+			total:    10,
+			want:     3,
+		},
+		{
+			name:     "ceil",
+			relative: "33%",
+			total:    10,
+			rounding: RoundCeil,
+			want:     4,
+		},
+		{
+			name:     "reject",
+			relative: "33%",
+			total:    10,
+			rounding: RoundReject,
+			wantErr:  ErrFractional,
+		},
+		{
+			name:     "overflow",
+			relative: "200%",
+			total:    8 * EiB,
+			wantErr:  ErrOverflow,
+		},
+		{
+			name:     "negative",
+			relative: "-10%",
+			wantErr:  ErrNegative,
+		},
+		{
+			name:     "invalid",
+			relative: "half%",
+			wantErr:  ErrInvalidNumber,
+		},
+		{
+			name:     "empty",
+			relative: "%",
+			wantErr:  ErrInvalidNumber,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relative, err := ParseRelative(tt.relative)
+			if err == nil {
+				var got Size
+				if got, err = relative.Resolve(tt.total, tt.rounding); got != tt.want {
+					t.Errorf("Resolve() got = %d, want %d", got, tt.want)
+				}
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseRelative() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRelative_String(t *testing.T) {
+	tests := []struct {
+		relative string
+		want     string
+	}{
+		{relative: "75%", want: "75%"},
+		{relative: "0.25x", want: "25%"},
+		{relative: "1.5x", want: "150%"},
+		{relative: "12.50%", want: "12.5%"},
+		{relative: "0.001x", want: "0.1%"},
+		{relative: "0.00001x", want: "0.001%"},
+		{relative: "0%", want: "0%"},
+		{relative: "1e2x", want: "10000%"},
+		{relative: "2GiB", want: "2GiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.relative, func(t *testing.T) {
+			relative, err := ParseRelative(tt.relative)
+			if err != nil {
+				t.Fatalf("ParseRelative() error = %v", err)
+			}
+			if got := relative.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelative_JSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{
+			name: "percent",
+			json: `{"cache":"25%"}`,
+			want: `{"cache":"25%"}`,
+		},
+		{
+			name: "absolute",
+			json: `{"cache":"1.5GiB"}`,
+			want: `{"cache":"1536MiB"}`,
+		},
+		{
+			name: "bytes",
+			json: `{"cache":"1024"}`,
+			want: `{"cache":"1KiB"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config struct {
+				Cache Relative `json:"cache"`
+			}
+			if err := json.Unmarshal([]byte(tt.json), &config); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			data, err := json.Marshal(config)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleParseRelative() {
+	cache, _ := ParseRelative("25%")
+	memory, _ := cache.Resolve(16*GiB, RoundFloor)
+
+	fmt.Println(cache)
+	fmt.Println(memory)
+	// Output:
+	// 25%
+	// 4GiB
+}
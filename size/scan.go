@@ -0,0 +1,148 @@
+package size
+
+// maxUnitLength is the length of the longest unit the scanner can look up.
+const maxUnitLength = 16
+
+// token is a size split into its number and unit, offsets point into the scanned input.
+type token struct {
+	number       decimal
+	numberOffset int
+	unitOffset   int
+	end          int
+}
+
+// scanSize splits a size of the form number [" "] unit, surrounded by optional whitespace,
+// the unit may be empty and is validated by the caller.
+func scanSize[T string | []byte](input T) (token, error) {
+	return scanSizeWith(input, WhitespaceSingle, false)
+}
+
+// scanSizeWith is scanSize with the given whitespace policy and number syntax.
+func scanSizeWith[T string | []byte](input T, whitespace Whitespace, lenient bool) (token, error) {
+	start, end := 0, len(input)
+	for whitespace != WhitespaceNone && start < end && isSpace(input[start]) {
+		start++
+	}
+
+	for whitespace != WhitespaceNone && end > start && isSpace(input[end-1]) {
+		end--
+	}
+
+	if start < end && input[start] == '-' {
+		return token{}, &ParseError{Input: string(input), Offset: start, Kind: ErrNegative}
+	}
+
+	number, i, ok := scanDecimal(input[:end], start, lenient)
+
+	unit := i
+	switch {
+	case whitespace == WhitespaceSingle && unit < end && input[unit] == ' ':
+		unit++
+	case whitespace == WhitespaceAny:
+		for unit < end && isSpace(input[unit]) {
+			unit++
+		}
+	}
+
+	if !ok || (unit < end && unit == i && !isLetter(input[unit])) {
+		return token{}, &ParseError{Input: string(input), Offset: start, Kind: ErrInvalidNumber}
+	}
+
+	return token{number: number, numberOffset: start, unitOffset: unit, end: end}, nil
+}
+
+// parseSize parses a size according to the given specification, a nil specification
+// selects binaryUnits or decimalUnits by the presence of the 'i' in the unit.
+func parseSize[T string | []byte](input T, units Units, optionalByte bool, rounding Rounding) (Size, error) {
+	tok, err := scanSize(input)
+	if err != nil {
+		return 0, err
+	}
+
+	name := input[tok.unitOffset:tok.end]
+	if len(name) == 0 && (!optionalByte || units == nil) {
+		return 0, &ParseError{Input: string(input), Offset: tok.unitOffset, Kind: ErrMissingUnit}
+	}
+
+	available := units
+	if units == nil {
+		units, available = decimalUnits, allUnits
+		if len(name) > 1 && toLower(name[1]) == 'i' {
+			units = binaryUnits
+		}
+	}
+
+	unit, exist := lookupUnit(name, units, optionalByte)
+	if !exist {
+		unit, exist = lookupLongName(name, units, false)
+	}
+
+	if !exist {
+		return 0, unknownUnitError(string(input), tok.unitOffset, string(name), unitNames(available))
+	}
+
+	value, err := tok.number.scale(unit, rounding)
+	if err != nil {
+		return 0, &ParseError{Input: string(input), Offset: tok.numberOffset, Kind: err}
+	}
+
+	return value, nil
+}
+
+// lookupUnit finds the unit case-insensitively without allocating,
+// the 'b' suffix is appended to the name when it is optional and omitted.
+func lookupUnit[T string | []byte, V any](name T, units map[string]V, optionalByte bool) (V, bool) {
+	var (
+		buffer [maxUnitLength]byte
+		unit   V
+	)
+
+	if len(name) >= len(buffer) {
+		return unit, false
+	}
+
+	n := copy(buffer[:], name)
+	for i := 0; i < n; i++ {
+		buffer[i] = toLower(buffer[i])
+	}
+
+	if optionalByte && (n == 0 || buffer[n-1] != 'b') {
+		buffer[n] = 'b'
+		n++
+	}
+
+	unit, exist := units[string(buffer[:n])]
+	return unit, exist
+}
+
+func unknownUnitError(input string, offset int, unit string, available []string) *ParseError {
+	return &ParseError{
+		Input:       input,
+		Offset:      offset,
+		Kind:        ErrUnknownUnit,
+		Unit:        unit,
+		Available:   available,
+		Suggestions: suggestUnits(unit, available),
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// isLetter reports whether c is an ASCII letter or a part of a multi-byte UTF-8 sequence.
+func isLetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c >= 0x80
+}
+
+func toLower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+
+	return c
+}
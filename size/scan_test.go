@@ -0,0 +1,259 @@
+package size
+
+import "testing"
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    Size
+		wantErr bool
+	}{
+		{
+			name: "binary",
+			size: "32.5 GiB",
+			want: 32*GiB + 512*MiB,
+		},
+		{
+			name: "decimal",
+			size: "\t512kb\n",
+			want: 512 * KB,
+		},
+		{
+			name: "singleDigit",
+			size: "5GB",
+			want: 5 * GB,
+		},
+		{
+			name: "bareNumber",
+			size: "512",
+			want: 512,
+		},
+		{
+			name:    "twoSpaces",
+			size:    "512  MB",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			size:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBytes([]byte(tt.size))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBytes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseBytes() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Allocations(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func()
+	}{
+		{
+			name:  "Parse",
+			parse: func() { _, _ = Parse("32.5 GiB") },
+		},
+		{
+			name:  "ParseBytes",
+			parse: func() { _, _ = ParseBytes([]byte("32.5 GiB")) },
+		},
+		{
+			name:  "ParseHuman",
+			parse: func() { _, _ = ParseHuman("512k") },
+		},
+		{
+			name:  "ParseUnits",
+			parse: func() { _, _ = ParseUnits("512MiB", binaryUnits) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.parse); allocs != 0 {
+				t.Errorf("%s() allocs = %v, want 0", tt.name, allocs)
+			}
+		})
+	}
+}
+
+func TestParseHuman_NumberGrammar(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		lenient bool
+		want    Size
+		wantErr bool
+	}{
+		{
+			name: "singleDigit",
+			size: "5GB",
+			want: 5 * GB,
+		},
+		{
+			name: "zero",
+			size: "0",
+			want: 0,
+		},
+		{
+			name: "leadingDot",
+			size: ".5GB",
+			want: 500 * MB,
+		},
+		{
+			name: "trailingDot",
+			size: "5.GB",
+			want: 5 * GB,
+		},
+		{
+			name: "exponent",
+			size: "1e3MB",
+			want: GB,
+		},
+		{
+			name: "exponent/Upper",
+			size: "1E3 MB",
+			want: GB,
+		},
+		{
+			name: "exponent/Plus",
+			size: "1.5e+2kB",
+			want: 150 * KB,
+		},
+		{
+			name: "exponent/Minus",
+			size: "2500e-3MB",
+			want: 2500 * KB,
+		},
+		{
+			name: "exponent/NoUnit",
+			size: "1e3",
+			want: 1000,
+		},
+		{
+			name: "exponent/ExaByte",
+			size: "1EB",
+			want: EB,
+		},
+		{
+			name: "exponent/ExaByteNoSuffix",
+			size: "2e",
+			want: 2 * EB,
+		},
+		{
+			name: "underscore",
+			size: "1_000_000B",
+			want: MB,
+		},
+		{
+			name: "underscore/Fraction",
+			size: "0.000_5GB",
+			want: 500 * KB,
+		},
+		{
+			name:    "thousands/Lenient",
+			size:    "1,048,576B",
+			lenient: true,
+			want:    1048576,
+		},
+		{
+			name:    "thousands/LenientShortGroup",
+			size:    "12,345.5 kB",
+			lenient: true,
+			want:    12345500,
+		},
+		{
+			name:    "thousands/Strict",
+			size:    "1,048,576B",
+			wantErr: true,
+		},
+		{
+			name:    "thousands/BadGroup",
+			size:    "1,04,576B",
+			lenient: true,
+			wantErr: true,
+		},
+		{
+			name:    "thousands/LongGroup",
+			size:    "1234,576B",
+			lenient: true,
+			wantErr: true,
+		},
+		{
+			name:    "thousands/Trailing",
+			size:    "1,048,B",
+			lenient: true,
+			wantErr: true,
+		},
+		{
+			name:    "underscore/Leading",
+			size:    "_1MB",
+			wantErr: true,
+		},
+		{
+			name:    "underscore/Trailing",
+			size:    "1_MB",
+			wantErr: true,
+		},
+		{
+			name:    "underscore/Double",
+			size:    "1__0MB",
+			wantErr: true,
+		},
+		{
+			name:    "underscore/BeforeDot",
+			size:    "1_.5MB",
+			wantErr: true,
+		},
+		{
+			name:    "dot",
+			size:    ".MB",
+			wantErr: true,
+		},
+		{
+			name:    "twoDots",
+			size:    "1.2.3MB",
+			wantErr: true,
+		},
+		{
+			name:    "exponent/TooLarge",
+			size:    "1e1001B",
+			wantErr: true,
+		},
+		{
+			name:    "exponent/Overflow",
+			size:    "1e20B",
+			wantErr: true,
+		},
+		{
+			name: "exponent/Fraction",
+			size: "1e-1B",
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parse := ParseHuman
+			if tt.lenient {
+				parse = NewParser(WithLenient(), WithAllowedSystems(Decimal)).Parse
+			}
+
+			got, err := parse(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseHuman() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseHuman() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
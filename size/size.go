@@ -2,8 +2,6 @@ package size
 
 import (
 	"fmt"
-	"github.com/spf13/cast"
-	"github.com/thoas/go-funk"
 	"regexp"
 	"strings"
 )
@@ -14,17 +12,19 @@ const (
 	DecimalBase = 1000
 	BinaryBase  = 1024
 
-	KB = DecimalBase
-	MB = DecimalBase * KB
-	GB = DecimalBase * MB
-	TB = DecimalBase * GB
-	PB = DecimalBase * TB
+	KB Size = DecimalBase
+	MB      = DecimalBase * KB
+	GB      = DecimalBase * MB
+	TB      = DecimalBase * GB
+	PB      = DecimalBase * TB
+	EB      = DecimalBase * PB
 
-	KiB = BinaryBase
-	MiB = BinaryBase * KiB
-	GiB = BinaryBase * MiB
-	TiB = BinaryBase * GiB
-	PiB = BinaryBase * TiB
+	KiB Size = BinaryBase
+	MiB      = BinaryBase * KiB
+	GiB      = BinaryBase * MiB
+	TiB      = BinaryBase * GiB
+	PiB      = BinaryBase * TiB
+	EiB      = BinaryBase * PiB
 
 	Byte Suffix = "B"
 
@@ -33,12 +33,14 @@ const (
 	GigaByte        = "GB"
 	TeraByte        = "TB"
 	PetaByte        = "PB"
+	ExaByte         = "EB"
 
 	KibiByte Suffix = "KiB"
 	MebiByte        = "MiB"
 	GibiByte        = "GiB"
 	TebiByte        = "TiB"
 	PebiByte        = "PiB"
+	ExbiByte        = "EiB"
 
 	FormatDefault = "%.4g%s"
 )
@@ -46,11 +48,11 @@ const (
 type Suffix string
 
 // Units provides a specification of the relationship of the suffix to the size.
-type Units map[string]uint64
+type Units map[string]Size
 
 // Suffixes represents the specification of the ratio of size to suffix.
 type Suffixes []*struct {
-	Unit   uint64
+	Unit   Size
 	Suffix Suffix
 }
 
@@ -62,6 +64,7 @@ var (
 		strings.ToLower(string(GigaByte)): GB,
 		strings.ToLower(string(TeraByte)): TB,
 		strings.ToLower(string(PetaByte)): PB,
+		strings.ToLower(string(ExaByte)):  EB,
 	}
 
 	binaryUnits = Units{
@@ -71,6 +74,7 @@ var (
 		strings.ToLower(string(GibiByte)): GiB,
 		strings.ToLower(string(TebiByte)): TiB,
 		strings.ToLower(string(PebiByte)): PiB,
+		strings.ToLower(string(ExbiByte)): EiB,
 	}
 
 	decimalSuffixes = Suffixes{
@@ -98,6 +102,10 @@ var (
 			Unit:   PB,
 			Suffix: PetaByte,
 		},
+		{
+			Unit:   EB,
+			Suffix: ExaByte,
+		},
 	}
 
 	binarySuffixes = Suffixes{
@@ -125,40 +133,32 @@ var (
 			Unit:   PiB,
 			Suffix: PebiByte,
 		},
+		{
+			Unit:   EiB,
+			Suffix: ExbiByte,
+		},
 	}
 
-	DecimalSizeRegexp = regexp.MustCompile(`(?m)^(\d+[\d\.]+?) ?([kKmMgGtTpPbB][bB]?)$`)
-
-	BinarySizeRegexp = regexp.MustCompile(`(?m)^(\d+[\d\.]+?) ?([kKmMgGtTpPbB][iI][bB]?)$`)
+	// Deprecated: DecimalSizeRegexp is no longer used by Parse, which relies on an allocation-free scanner.
+	DecimalSizeRegexp = regexp.MustCompile(`(?m)^(\d+[\d\.]+?) ?([kKmMgGtTpPeEbB][bB]?)$`)
 
-	splitRegexp = regexp.MustCompile(`(?m)(\d+[\d\.]+?) ?([A-Za-z]+)$`)
+	// Deprecated: BinarySizeRegexp is no longer used by Parse, which relies on an allocation-free scanner.
+	BinarySizeRegexp = regexp.MustCompile(`(?m)^(\d+[\d\.]+?) ?([kKmMgGtTpPeEbB][iI][bB]?)$`)
 )
 
 // ParseSize defines the IEC/SI prefix and returns int64 as an integer or returns an error if it fails,
-// units are case-insensitive, and the 'b' suffix is optional.
+// units are case-insensitive, the 'b' suffix is optional, and numbers without a unit are bytes.
 func ParseSize(size string) (uint64, error) {
-	if DecimalSizeRegexp.MatchString(size) {
-		return FromHumanSize(size)
-	}
-
-	if BinarySizeRegexp.MatchString(size) {
-		return FromBinarySize(size)
-	}
-
-	return 0, fmt.Errorf("size: format size '%s' unknown", size)
+	value, err := Parse(size)
+	return uint64(value), err
 }
 
 // FromHumanSize returns an integer from a human-readable specification of a
 // size using SI standard (eg. "512kB", "20MB") or returns an error if it fails,
 // units are case-insensitive, and the 'b' suffix is optional.
 func FromHumanSize(size string) (uint64, error) {
-	size = strings.TrimSpace(size)
-
-	if !strings.HasSuffix(size, "b") && !strings.HasSuffix(size, "B") {
-		size += string(Byte)
-	}
-
-	return FromSize(size, decimalUnits)
+	value, err := ParseHuman(size)
+	return uint64(value), err
 }
 
 // FromBinarySize parses a human-readable string representing an amount of RAM
@@ -166,41 +166,66 @@ func FromHumanSize(size string) (uint64, error) {
 // returns the number of bytes or returns an error if it fails,
 // units are case-insensitive, and the 'b' suffix is optional.
 func FromBinarySize(size string) (uint64, error) {
-	size = strings.TrimSpace(size)
-
-	if !strings.HasSuffix(size, "b") && !strings.HasSuffix(size, "B") {
-		size += string(Byte)
-	}
-
-	return FromSize(size, binaryUnits)
+	value, err := ParseBinary(size)
+	return uint64(value), err
 }
 
 // FromSize parses the human-readable size string into the amount it represents,
 // according to the given specification or returns an error if it fails.
 func FromSize(size string, units Units) (uint64, error) {
-	matches := splitRegexp.FindAllStringSubmatch(size, -1)
-	if len(matches) == 0 {
-		return 0, fmt.Errorf("size: invalid format size '%s'", size)
-	}
+	value, err := ParseUnits(size, units)
+	return uint64(value), err
+}
 
-	if len(matches[0]) != 3 {
-		return 0, fmt.Errorf("size: invalid format size '%s'", size)
-	}
+// FromSizeRounding is FromSize with the given Rounding for values that resolve to a fractional number of bytes.
+func FromSizeRounding(size string, units Units, rounding Rounding) (uint64, error) {
+	value, err := ParseUnitsRounding(size, units, rounding)
+	return uint64(value), err
+}
 
-	unit, exist := units[strings.ToLower(strings.TrimSpace(matches[0][2]))]
-	if !exist {
-		return 0, fmt.Errorf("size: unit '%s' unknown, available units [%s]",
-			matches[0][2],
-			strings.Join(cast.ToStringSlice(funk.Keys(units)), ", "),
-		)
-	}
+// Parse defines the IEC/SI prefix and returns the Size or returns an error if it fails,
+// units are case-insensitive, the 'b' suffix is optional, and numbers without a unit are bytes,
+// it is the Parser created without options.
+func Parse(size string) (Size, error) {
+	return defaultParser.Parse(size)
+}
 
-	unitSize, err := cast.ToFloat64E(matches[0][1])
-	if err != nil {
-		return 0, fmt.Errorf("size: cast invalid: %w", err)
-	}
+// ParseDefault is like Parse but reads numbers without a unit in the given unit
+// (eg. "64" is 64 MiB with the MiB unit), a zero unit rejects them with ErrMissingUnit.
+func ParseDefault(size string, unit Size) (Size, error) {
+	return defaultParser.ParseDefault(size, unit)
+}
+
+// ParseBytes is like Parse but takes the size as a byte slice,
+// it does not allocate unless the size is invalid.
+func ParseBytes(size []byte) (Size, error) {
+	return defaultParser.ParseBytes(size)
+}
+
+// ParseHuman returns the Size from a human-readable specification of a
+// size using SI standard (eg. "512kB", "20MB") or returns an error if it fails,
+// units are case-insensitive, and the 'b' suffix is optional.
+func ParseHuman(size string) (Size, error) {
+	return parseSize(size, decimalUnits, true, DefaultRounding)
+}
+
+// ParseBinary parses a human-readable string representing an amount of RAM
+// in bytes, kibibytes, mebibytes, gibibytes, or tebibytes and
+// returns the Size or returns an error if it fails,
+// units are case-insensitive, and the 'b' suffix is optional.
+func ParseBinary(size string) (Size, error) {
+	return parseSize(size, binaryUnits, true, DefaultRounding)
+}
+
+// ParseUnits parses the human-readable size string into the Size it represents,
+// according to the given specification or returns an error if it fails.
+func ParseUnits(size string, units Units) (Size, error) {
+	return parseSize(size, units, false, DefaultRounding)
+}
 
-	return uint64(unitSize * float64(unit)), nil
+// ParseUnitsRounding is ParseUnits with the given Rounding for values that resolve to a fractional number of bytes.
+func ParseUnitsRounding(size string, units Units, rounding Rounding) (Size, error) {
+	return parseSize(size, units, false, rounding)
 }
 
 // FormatHuman returns a human-readable approximation of a size
@@ -210,7 +235,7 @@ func FormatHuman(unit uint64) string {
 }
 
 // FormatBinary returns a human-readable size in bytes, kibibytes,
-// mebibytes, gibibytes, or tebibytes (eg. "512kiB", "4PiB").
+// mebibytes, gibibytes, tebibytes, pebibytes or exbibytes (eg. "512kiB", "4PiB").
 func FormatBinary(unit uint64) string {
 	return FormatSize(FormatDefault, unit, binarySuffixes)
 }
@@ -1,6 +1,7 @@
 package size
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -204,6 +205,11 @@ func TestFormatBinary(t *testing.T) {
 			args: args{unit: 512 * (1000 * 1000 * 1000 * 1000 * 1000)},
 			want: "454.7PiB",
 		},
+		{
+			name: "ExbiByte",
+			args: args{unit: 15 * (1024 * 1024 * 1024 * 1024 * 1024 * 1024)},
+			want: "15EiB",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -278,6 +284,11 @@ func TestFormatHuman(t *testing.T) {
 			args: args{unit: 512 * (1024 * 1024 * 1024 * 1024 * 1024)},
 			want: "576.5PB",
 		},
+		{
+			name: "ExaByte",
+			args: args{unit: 18 * (1000 * 1000 * 1000 * 1000 * 1000 * 1000)},
+			want: "18EB",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -664,6 +675,35 @@ func TestFromSize(t *testing.T) {
 	}
 }
 
+func TestFromSizeRounding(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     string
+		rounding Rounding
+		want     uint64
+		wantErr  error
+	}{
+		{name: "floor", size: "0.1KiB", rounding: RoundFloor, want: 102},
+		{name: "ceil", size: "0.1KiB", rounding: RoundCeil, want: 103},
+		{name: "halfEven", size: "0.5B", rounding: RoundHalfEven, want: 0},
+		{name: "halfEven/up", size: "1.5B", rounding: RoundHalfEven, want: 2},
+		{name: "reject", size: "0.1KiB", rounding: RoundReject, wantErr: ErrFractional},
+		{name: "reject/exact", size: "0.5KiB", rounding: RoundReject, want: 512},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromSizeRounding(tt.size, binaryUnits, tt.rounding)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromSizeRounding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FromSizeRounding() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseSize(t *testing.T) {
 	type args struct {
 		size string
@@ -684,6 +724,11 @@ func TestParseSize(t *testing.T) {
 			args: args{size: "512b"},
 			want: 512,
 		},
+		{
+			name: "Byte/NoSuffix",
+			args: args{size: "512"},
+			want: 512,
+		},
 		{
 			name: "binary/KibiByte",
 			args: args{size: "512KiB"},
@@ -759,6 +804,11 @@ func TestParseSize(t *testing.T) {
 			args: args{size: "512pib"},
 			want: 512 * (1024 * 1024 * 1024 * 1024 * 1024),
 		},
+		{
+			name: "binary/ExbiByte",
+			args: args{size: "15EiB"},
+			want: 15 * (1024 * 1024 * 1024 * 1024 * 1024 * 1024),
+		},
 
 		{
 			name: "decimal/Byte",
@@ -825,6 +875,11 @@ func TestParseSize(t *testing.T) {
 			args: args{size: "512pb"},
 			want: 512 * (1000 * 1000 * 1000 * 1000 * 1000),
 		},
+		{
+			name: "decimal/ExaByte",
+			args: args{size: "18EB"},
+			want: 18 * (1000 * 1000 * 1000 * 1000 * 1000 * 1000),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -843,9 +898,9 @@ func TestParseSize(t *testing.T) {
 // Examples
 
 func ExampleFormatBinary() {
-	MiB5_6 := 5.6 * MiB
-	GiB8_6 := 8.6 * GiB
-	PiB2_7 := 2.7 * PiB
+	MiB5_6 := 5.6 * float64(MiB)
+	GiB8_6 := 8.6 * float64(GiB)
+	PiB2_7 := 2.7 * float64(PiB)
 
 	fmt.Println(FormatBinary(1))
 	fmt.Println(FormatBinary(1024))
@@ -858,9 +913,9 @@ func ExampleFormatBinary() {
 }
 
 func ExampleFormatHuman() {
-	MB5_6 := 5.6 * MB
-	GB8_6 := 8.6 * GB
-	PB2_7 := 2.7 * PB
+	MB5_6 := 5.6 * float64(MB)
+	GB8_6 := 8.6 * float64(GB)
+	PB2_7 := 2.7 * float64(PB)
 
 	fmt.Println(FormatHuman(1))
 	fmt.Println(FormatHuman(1000))
@@ -953,3 +1008,93 @@ func BenchmarkParseSize(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkFromHumanSize(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = FromHumanSize("32.5 MB")
+	}
+}
+
+func BenchmarkFromBinarySize(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = FromBinarySize("32.5 MiB")
+	}
+}
+
+func BenchmarkFromSize(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = FromSize("32.5MiB", binaryUnits)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("32.5 GiB")
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	size := []byte("32.5 GiB")
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseBytes(size)
+	}
+}
+
+func BenchmarkParseHuman(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseHuman("32.5 GB")
+	}
+}
+
+func BenchmarkParseBinary(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseBinary("32.5 GiB")
+	}
+}
+
+func BenchmarkParseUnits(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseUnits("32.5GB", decimalUnits)
+	}
+}
+
+func BenchmarkFormatHuman(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatHuman(uint64(32*GB + 512*MB))
+	}
+}
+
+func BenchmarkFormatBinary(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatBinary(uint64(32*GiB + 512*MiB))
+	}
+}
+
+func BenchmarkFormatSize(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = FormatSize(FormatDefault, uint64(32*GiB+512*MiB), binarySuffixes)
+	}
+}
@@ -0,0 +1,52 @@
+package size
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// ValueEncoding is the representation used by Size.Value when the size is written to a database,
+// EncodingBytes stores an integer suitable for BIGINT columns, string encodings suit TEXT columns.
+var ValueEncoding = EncodingBytes
+
+// Scan implements sql.Scanner, it accepts integer columns holding a number of bytes
+// and text columns holding either a number of bytes or a size string.
+func (s *Size) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case int64:
+		if value < 0 {
+			return fmt.Errorf("size: cannot scan value %d: %w", value, ErrNegative)
+		}
+
+		*s = Size(value)
+		return nil
+	case []byte:
+		return s.UnmarshalText(value)
+	case string:
+		return s.UnmarshalText([]byte(value))
+	case nil:
+		return fmt.Errorf("size: cannot scan NULL")
+	}
+
+	return fmt.Errorf("size: cannot scan type %T", src)
+}
+
+// Value implements driver.Valuer according to ValueEncoding,
+// it returns an error if the size does not fit into int64.
+func (s Size) Value() (driver.Value, error) {
+	switch ValueEncoding {
+	case EncodingBytes:
+		value, err := s.Int64()
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	case EncodingBinary:
+		return formatExact(s, binarySuffixes), nil
+	case EncodingDecimal:
+		return formatExact(s, decimalSuffixes), nil
+	}
+
+	return nil, fmt.Errorf("size: encoding '%d' unknown", ValueEncoding)
+}
@@ -0,0 +1,126 @@
+package size
+
+import (
+	"database/sql/driver"
+	"math"
+	"testing"
+)
+
+func TestSize_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Size
+		wantErr bool
+	}{
+		{
+			name: "int64",
+			src:  int64(2147483648),
+			want: 2 * GiB,
+		},
+		{
+			name: "bytes/String",
+			src:  []byte("20GiB"),
+			want: 20 * GiB,
+		},
+		{
+			name: "bytes/Integer",
+			src:  []byte("4096"),
+			want: 4096,
+		},
+		{
+			name: "string",
+			src:  "512MB",
+			want: 512 * MB,
+		},
+		{
+			name:    "negative",
+			src:     int64(-1),
+			wantErr: true,
+		},
+		{
+			name:    "null",
+			src:     nil,
+			wantErr: true,
+		},
+		{
+			name:    "float",
+			src:     1.5,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			src:     "lots",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Size
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		size     Size
+		want     driver.Value
+		wantErr  bool
+	}{
+		{
+			name:     "bytes",
+			encoding: EncodingBytes,
+			size:     2 * GiB,
+			want:     int64(2147483648),
+		},
+		{
+			name:     "bytes/MaxInt64",
+			encoding: EncodingBytes,
+			size:     math.MaxInt64,
+			want:     int64(math.MaxInt64),
+		},
+		{
+			name:     "bytes/Overflow",
+			encoding: EncodingBytes,
+			size:     math.MaxInt64 + 1,
+			wantErr:  true,
+		},
+		{
+			name:     "binary",
+			encoding: EncodingBinary,
+			size:     2 * GiB,
+			want:     "2GiB",
+		},
+		{
+			name:     "decimal",
+			encoding: EncodingDecimal,
+			size:     250 * MB,
+			want:     "250MB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(encoding Encoding) { ValueEncoding = encoding }(ValueEncoding)
+			ValueEncoding = tt.encoding
+
+			got, err := tt.size.Value()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Value() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
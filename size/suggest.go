@@ -0,0 +1,166 @@
+package size
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestionDistance is the largest edit distance at which a unit is still suggested.
+const maxSuggestionDistance = 2
+
+var canonicalSuffixes = newCanonicalSuffixes(decimalSuffixes, binarySuffixes)
+
+// unitNames returns the names of the units ordered by size and then by name,
+// built-in units are spelled as their canonical Suffix (eg. "kB", "KiB").
+func unitNames(units Units) []string {
+	names := sortedNames(units)
+	for i, name := range names {
+		if suffix, exist := canonicalSuffixes[name]; exist {
+			names[i] = string(suffix)
+		}
+	}
+
+	return names
+}
+
+// sortedNames returns the names of the units ordered by size and then by name.
+func sortedNames(units Units) []string {
+	names := make([]string, 0, len(units))
+	for name := range units {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if units[names[i]] != units[names[j]] {
+			return units[names[i]] < units[names[j]]
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+// suggestUnits returns the available units the unknown unit was most likely meant to be,
+// common mistakes ("mbyte", "MBi") are recognised first, then the closest units by edit distance.
+// Units tied at the closest distance are narrowed to those starting with the same letter, and they
+// are only all suggested when the distance is small against the length of the unit, otherwise
+// nearly any short unit would be suggested (eg. every unit for "ZB").
+func suggestUnits(unit string, available []string) []string {
+	unit = strings.ToLower(unit)
+
+	if corrected := correctUnit(unit); corrected != unit {
+		for _, name := range available {
+			if strings.ToLower(name) == corrected {
+				return []string{name}
+			}
+		}
+	}
+
+	var suggestions []string
+
+	best := maxSuggestionDistance
+	for _, name := range available {
+		distance := editDistance(unit, strings.ToLower(name))
+		if distance >= len(unit) || distance > best {
+			continue
+		}
+
+		if distance < best {
+			best, suggestions = distance, suggestions[:0]
+		}
+
+		suggestions = append(suggestions, name)
+	}
+
+	if len(suggestions) > 1 {
+		suggestions = sameInitial(unit, suggestions)
+	}
+
+	if len(suggestions) > 1 && best >= len(unit)-1 {
+		return nil
+	}
+
+	return suggestions
+}
+
+// sameInitial returns the names starting with the same letter as the unit, case-insensitively.
+func sameInitial(unit string, names []string) []string {
+	var same []string
+	for _, name := range names {
+		if name != "" && toLower(name[0]) == unit[0] {
+			same = append(same, name)
+		}
+	}
+
+	return same
+}
+
+// correctUnit fixes the common ways of misspelling a unit:
+// spelled out bytes ("mbyte", "kbytes") and a swapped binary marker ("MBi").
+func correctUnit(unit string) string {
+	for _, spelled := range []string{"bytes", "byte"} {
+		if strings.HasSuffix(unit, spelled) {
+			return strings.TrimSuffix(unit, spelled) + "b"
+		}
+	}
+
+	if strings.HasSuffix(unit, "bi") {
+		return strings.TrimSuffix(unit, "bi") + "ib"
+	}
+
+	return unit
+}
+
+// editDistance returns the optimal string alignment distance between a and b,
+// that is the Levenshtein distance where swapping two adjacent bytes counts as one edit.
+func editDistance(a, b string) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			rows[i][j] = minInt(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = minInt(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return rows[len(a)][len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
+
+func newCanonicalSuffixes(suffixes ...Suffixes) map[string]Suffix {
+	canonical := map[string]Suffix{}
+	for _, list := range suffixes {
+		for _, suffix := range list {
+			canonical[strings.ToLower(string(suffix.Suffix))] = suffix.Suffix
+		}
+	}
+
+	return canonical
+}
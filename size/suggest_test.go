@@ -0,0 +1,191 @@
+package size
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Tests
+
+func TestUnitNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		units Units
+		want  []string
+	}{
+		{
+			name:  "decimal",
+			units: decimalUnits,
+			want:  []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"},
+		},
+		{
+			name:  "binary",
+			units: binaryUnits,
+			want:  []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"},
+		},
+		{
+			name:  "all",
+			units: allUnits,
+			want:  []string{"B", "kB", "KiB", "MB", "MiB", "GB", "GiB", "TB", "TiB", "PB", "PiB", "EB", "EiB"},
+		},
+		{
+			name:  "custom",
+			units: Units{"page": 4 * KiB, "word": 8, "byte": ByteBase, "octet": ByteBase},
+			want:  []string{"byte", "octet", "word", "page"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				if got := unitNames(tt.units); !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("unitNames() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSuggestUnits(t *testing.T) {
+	tests := []struct {
+		name      string
+		unit      string
+		available []string
+		want      []string
+	}{
+		{
+			name:      "swappedBinaryMarker",
+			unit:      "MBi",
+			available: unitNames(allUnits),
+			want:      []string{"MiB"},
+		},
+		{
+			name:      "spelledByte",
+			unit:      "mbyte",
+			available: unitNames(allUnits),
+			want:      []string{"MB"},
+		},
+		{
+			name:      "spelledBytes",
+			unit:      "GBytes",
+			available: unitNames(allUnits),
+			want:      []string{"GB"},
+		},
+		{
+			name:      "binaryInDecimal",
+			unit:      "Gib",
+			available: unitNames(decimalUnits),
+			want:      []string{"GB"},
+		},
+		{
+			name:      "decimalInBinary",
+			unit:      "Kb",
+			available: unitNames(binaryUnits),
+			want:      []string{"KiB"},
+		},
+		{
+			name:      "typo",
+			unit:      "GjB",
+			available: unitNames(allUnits),
+			want:      []string{"GB", "GiB"},
+		},
+		{
+			name:      "transposition",
+			unit:      "iMB",
+			available: unitNames(binaryUnits),
+			want:      []string{"MiB"},
+		},
+		{
+			name:      "ambiguous",
+			unit:      "ZB",
+			available: unitNames(allUnits),
+			want:      nil,
+		},
+		{
+			name:      "ambiguousBinary",
+			unit:      "XiB",
+			available: unitNames(binaryUnits),
+			want:      nil,
+		},
+		{
+			name:      "unrelated",
+			unit:      "lots",
+			available: unitNames(allUnits),
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := suggestUnits(tt.unit, tt.available); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("suggestUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "kb", want: 2},
+		{a: "mib", b: "mib", want: 0},
+		{a: "mbi", b: "mib", want: 1},
+		{a: "gjb", b: "gb", want: 1},
+		{a: "kb", b: "kib", want: 1},
+		{a: "tib", b: "pb", want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := editDistance(tt.a, tt.b); got != tt.want {
+				t.Errorf("editDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_NoSuggestions(t *testing.T) {
+	_, err := Parse("1ZB")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Parse() error = %#v, want *ParseError", err)
+	}
+	if parseErr.Suggestions != nil {
+		t.Errorf("Suggestions = %v, want none", parseErr.Suggestions)
+	}
+}
+
+func TestParse_Suggestions(t *testing.T) {
+	_, err := ParseBinary("512MBi")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("ParseBinary() error = %#v, want *ParseError", err)
+	}
+	if !reflect.DeepEqual(parseErr.Suggestions, []string{"MiB"}) {
+		t.Errorf("Suggestions = %v, want %v", parseErr.Suggestions, []string{"MiB"})
+	}
+
+	want := "size: unit 'MBi' unknown, did you mean 'MiB'? available units [B, KiB, MiB, GiB, TiB, PiB, EiB]"
+	if err.Error() != want {
+		t.Errorf("Error() = %v, want %v", err, want)
+	}
+}
+
+// Examples
+
+func ExampleParseError() {
+	_, err := ParseHuman("512mbyte")
+
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Println(parseErr.Unit, parseErr.Suggestions)
+	}
+
+	fmt.Println(err)
+	// Output:
+	// mbyte [MB]
+	// size: unit 'mbyte' unknown, did you mean 'MB'? available units [B, kB, MB, GB, TB, PB, EB]
+}
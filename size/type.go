@@ -0,0 +1,86 @@
+package size
+
+// Size represents an amount of data in bytes.
+type Size uint64
+
+// String returns a human-readable representation of the size in the IEC
+// binary system (eg. "512KiB", "4PiB").
+func (s Size) String() string {
+	return FormatBinary(uint64(s))
+}
+
+// Human returns a human-readable representation of the size in the SI
+// decimal system (eg. "512kB", "20MB").
+func (s Size) Human() string {
+	return FormatHuman(uint64(s))
+}
+
+// Bytes returns the size as an integer byte count.
+func (s Size) Bytes() uint64 {
+	return uint64(s)
+}
+
+// KB returns the size as a floating point number of kilobytes.
+func (s Size) KB() float64 {
+	return s.in(KB)
+}
+
+// MB returns the size as a floating point number of megabytes.
+func (s Size) MB() float64 {
+	return s.in(MB)
+}
+
+// GB returns the size as a floating point number of gigabytes.
+func (s Size) GB() float64 {
+	return s.in(GB)
+}
+
+// TB returns the size as a floating point number of terabytes.
+func (s Size) TB() float64 {
+	return s.in(TB)
+}
+
+// PB returns the size as a floating point number of petabytes.
+func (s Size) PB() float64 {
+	return s.in(PB)
+}
+
+// EB returns the size as a floating point number of exabytes.
+func (s Size) EB() float64 {
+	return s.in(EB)
+}
+
+// KiB returns the size as a floating point number of kibibytes.
+func (s Size) KiB() float64 {
+	return s.in(KiB)
+}
+
+// MiB returns the size as a floating point number of mebibytes.
+func (s Size) MiB() float64 {
+	return s.in(MiB)
+}
+
+// GiB returns the size as a floating point number of gibibytes.
+func (s Size) GiB() float64 {
+	return s.in(GiB)
+}
+
+// TiB returns the size as a floating point number of tebibytes.
+func (s Size) TiB() float64 {
+	return s.in(TiB)
+}
+
+// PiB returns the size as a floating point number of pebibytes.
+func (s Size) PiB() float64 {
+	return s.in(PiB)
+}
+
+// EiB returns the size as a floating point number of exbibytes.
+func (s Size) EiB() float64 {
+	return s.in(EiB)
+}
+
+func (s Size) in(unit Size) float64 {
+	whole, rest := s/unit, s%unit
+	return float64(whole) + float64(rest)/float64(unit)
+}
@@ -0,0 +1,205 @@
+package size
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Tests
+
+func TestSize_String(t *testing.T) {
+	tests := []struct {
+		name string
+		size Size
+		want string
+	}{
+		{
+			name: "Byte",
+			size: 512,
+			want: "512B",
+		},
+		{
+			name: "MebiByte",
+			size: 512 * MiB,
+			want: "512MiB",
+		},
+		{
+			name: "MegaByteToMebiByte",
+			size: 512 * MB,
+			want: "488.3MiB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.size.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Human(t *testing.T) {
+	tests := []struct {
+		name string
+		size Size
+		want string
+	}{
+		{
+			name: "Byte",
+			size: 512,
+			want: "512B",
+		},
+		{
+			name: "MegaByte",
+			size: 512 * MB,
+			want: "512MB",
+		},
+		{
+			name: "MebiByteToMegaByte",
+			size: 512 * MiB,
+			want: "536.9MB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.size.Human(); got != tt.want {
+				t.Errorf("Human() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Accessors(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{
+			name: "KB",
+			got:  (1536 * KB).KB(),
+			want: 1536,
+		},
+		{
+			name: "MB",
+			got:  (1500 * KB).MB(),
+			want: 1.5,
+		},
+		{
+			name: "GB",
+			got:  (250 * MB).GB(),
+			want: 0.25,
+		},
+		{
+			name: "TB",
+			got:  (3 * TB).TB(),
+			want: 3,
+		},
+		{
+			name: "PB",
+			got:  (500 * TB).PB(),
+			want: 0.5,
+		},
+		{
+			name: "EB",
+			got:  (1500 * PB).EB(),
+			want: 1.5,
+		},
+		{
+			name: "KiB",
+			got:  Size(1536).KiB(),
+			want: 1.5,
+		},
+		{
+			name: "MiB",
+			got:  (1536 * KiB).MiB(),
+			want: 1.5,
+		},
+		{
+			name: "GiB",
+			got:  (256 * MiB).GiB(),
+			want: 0.25,
+		},
+		{
+			name: "TiB",
+			got:  (2048 * GiB).TiB(),
+			want: 2,
+		},
+		{
+			name: "PiB",
+			got:  (16*PiB + 512*TiB).PiB(),
+			want: 16.5,
+		},
+		{
+			name: "EiB",
+			got:  (15*EiB + 256*PiB).EiB(),
+			want: 15.25,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize_Bytes(t *testing.T) {
+	if got := (3 * GiB).Bytes(); got != 3*1024*1024*1024 {
+		t.Errorf("Bytes() = %v, want %v", got, 3*1024*1024*1024)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    string
+		want    Size
+		wantErr bool
+	}{
+		{
+			name: "binary/GibiByte",
+			size: "20GiB",
+			want: 20 * GiB,
+		},
+		{
+			name: "decimal/MegaByte",
+			size: "512MB",
+			want: 512 * MB,
+		},
+		{
+			name:    "unknown",
+			size:    "512XB",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Examples
+
+func ExampleSize() {
+	limit := 512 * MiB
+
+	fmt.Println(limit)
+	fmt.Println(limit.Human())
+	fmt.Println(limit.Bytes())
+	fmt.Println(limit.GiB())
+	// Output:
+	// 512MiB
+	// 536.9MB
+	// 536870912
+	// 0.5
+}